@@ -0,0 +1,105 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func (c *Client) GetRepositoryAccessToken(repositorySlug, id string) (*AccessToken, error) {
+	return c.getAccessToken(fmt.Sprintf("%s/repositories/%s/%s/access-tokens/%s", c.Host, c.Workspace, repositorySlug, url.PathEscape(id)))
+}
+
+func (c *Client) CreateRepositoryAccessToken(repositorySlug string, newAccessToken AccessToken) (*AccessToken, error) {
+	return c.createAccessToken(fmt.Sprintf("%s/repositories/%s/%s/access-tokens", c.Host, c.Workspace, repositorySlug), newAccessToken)
+}
+
+func (c *Client) DeleteRepositoryAccessToken(repositorySlug, id string) error {
+	return c.deleteAccessToken(fmt.Sprintf("%s/repositories/%s/%s/access-tokens/%s", c.Host, c.Workspace, repositorySlug, url.PathEscape(id)))
+}
+
+func (c *Client) GetProjectAccessToken(projectKey, id string) (*AccessToken, error) {
+	return c.getAccessToken(fmt.Sprintf("%s/workspaces/%s/projects/%s/access-tokens/%s", c.Host, c.Workspace, projectKey, url.PathEscape(id)))
+}
+
+func (c *Client) CreateProjectAccessToken(projectKey string, newAccessToken AccessToken) (*AccessToken, error) {
+	return c.createAccessToken(fmt.Sprintf("%s/workspaces/%s/projects/%s/access-tokens", c.Host, c.Workspace, projectKey), newAccessToken)
+}
+
+func (c *Client) DeleteProjectAccessToken(projectKey, id string) error {
+	return c.deleteAccessToken(fmt.Sprintf("%s/workspaces/%s/projects/%s/access-tokens/%s", c.Host, c.Workspace, projectKey, url.PathEscape(id)))
+}
+
+func (c *Client) GetWorkspaceAccessToken(id string) (*AccessToken, error) {
+	return c.getAccessToken(fmt.Sprintf("%s/workspaces/%s/access-tokens/%s", c.Host, c.Workspace, url.PathEscape(id)))
+}
+
+func (c *Client) CreateWorkspaceAccessToken(newAccessToken AccessToken) (*AccessToken, error) {
+	return c.createAccessToken(fmt.Sprintf("%s/workspaces/%s/access-tokens", c.Host, c.Workspace), newAccessToken)
+}
+
+func (c *Client) DeleteWorkspaceAccessToken(id string) error {
+	return c.deleteAccessToken(fmt.Sprintf("%s/workspaces/%s/access-tokens/%s", c.Host, c.Workspace, url.PathEscape(id)))
+}
+
+func (c *Client) getAccessToken(url string) (*AccessToken, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var accessToken AccessToken
+	err = json.Unmarshal(body, &accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accessToken, nil
+}
+
+func (c *Client) createAccessToken(url string, newAccessToken AccessToken) (*AccessToken, error) {
+	rb, err := json.Marshal(newAccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", url, strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var accessToken AccessToken
+	err = json.Unmarshal(body, &accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accessToken, nil
+}
+
+func (c *Client) deleteAccessToken(url string) error {
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
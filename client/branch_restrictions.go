@@ -8,23 +8,7 @@ import (
 )
 
 func (c *Client) GetBranchRestrictions(repositorySlug string) (*Paginated[BranchRestriction], error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/repositories/%s/%s/branch-restrictions", c.Host, c.Workspace, repositorySlug), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	var paginated Paginated[BranchRestriction]
-	err = json.Unmarshal(body, &paginated)
-	if err != nil {
-		return nil, err
-	}
-
-	return &paginated, nil
+	return getAllPages[BranchRestriction](c, fmt.Sprintf("%s/repositories/%s/%s/branch-restrictions", c.Host, c.Workspace, repositorySlug))
 }
 
 func (c *Client) GetBranchRestriction(repositorySlug string, id int) (*BranchRestriction, error) {
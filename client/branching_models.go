@@ -0,0 +1,70 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetBranchingModelSettings(repositorySlug string) (*BranchingModelSettings, error) {
+	return c.getBranchingModelSettings(fmt.Sprintf("%s/repositories/%s/%s/branching-model/settings", c.Host, c.Workspace, repositorySlug))
+}
+
+func (c *Client) UpdateBranchingModelSettings(repositorySlug string, newSettings BranchingModelSettings) (*BranchingModelSettings, error) {
+	return c.updateBranchingModelSettings(fmt.Sprintf("%s/repositories/%s/%s/branching-model/settings", c.Host, c.Workspace, repositorySlug), newSettings)
+}
+
+func (c *Client) GetProjectBranchingModelSettings(projectKey string) (*BranchingModelSettings, error) {
+	return c.getBranchingModelSettings(fmt.Sprintf("%s/workspaces/%s/projects/%s/branching-model/settings", c.Host, c.Workspace, projectKey))
+}
+
+func (c *Client) UpdateProjectBranchingModelSettings(projectKey string, newSettings BranchingModelSettings) (*BranchingModelSettings, error) {
+	return c.updateBranchingModelSettings(fmt.Sprintf("%s/workspaces/%s/projects/%s/branching-model/settings", c.Host, c.Workspace, projectKey), newSettings)
+}
+
+func (c *Client) getBranchingModelSettings(url string) (*BranchingModelSettings, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var settings BranchingModelSettings
+	err = json.Unmarshal(body, &settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+func (c *Client) updateBranchingModelSettings(url string, newSettings BranchingModelSettings) (*BranchingModelSettings, error) {
+	rb, err := json.Marshal(newSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", url, strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var settings BranchingModelSettings
+	err = json.Unmarshal(body, &settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
@@ -89,3 +89,41 @@ func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 
 	return body, err
 }
+
+func getAllPages[T any](c *Client, url string) (*Paginated[T], error) {
+	var all Paginated[T]
+
+	for url != "" {
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return nil, err
+		}
+
+		var page Paginated[T]
+		err = json.Unmarshal(body, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		all.Values = append(all.Values, page.Values...)
+		url = page.Next
+	}
+
+	return &all, nil
+}
+
+func (c *Client) WithWorkspace(workspace string) *Client {
+	if workspace == "" || workspace == c.Workspace {
+		return c
+	}
+
+	workspaceClient := *c
+	workspaceClient.Workspace = workspace
+
+	return &workspaceClient
+}
@@ -0,0 +1,120 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) GetDefaultReviewers(repositorySlug string) (*Paginated[User], error) {
+	return getAllPages[User](c, fmt.Sprintf("%s/repositories/%s/%s/default-reviewers", c.Host, c.Workspace, repositorySlug))
+}
+
+func (c *Client) GetDefaultReviewer(repositorySlug, user string) (*User, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/repositories/%s/%s/default-reviewers/%s", c.Host, c.Workspace, repositorySlug, url.PathEscape(user)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var defaultReviewer User
+	err = json.Unmarshal(body, &defaultReviewer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &defaultReviewer, nil
+}
+
+func (c *Client) CreateDefaultReviewer(repositorySlug, user string) (*User, error) {
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/repositories/%s/%s/default-reviewers/%s", c.Host, c.Workspace, repositorySlug, url.PathEscape(user)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var defaultReviewer User
+	err = json.Unmarshal(body, &defaultReviewer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &defaultReviewer, nil
+}
+
+func (c *Client) DeleteDefaultReviewer(repositorySlug, user string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/repositories/%s/%s/default-reviewers/%s", c.Host, c.Workspace, repositorySlug, url.PathEscape(user)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) GetProjectDefaultReviewer(projectKey, user string) (*User, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/workspaces/%s/projects/%s/default-reviewers/%s", c.Host, c.Workspace, projectKey, url.PathEscape(user)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var defaultReviewer User
+	err = json.Unmarshal(body, &defaultReviewer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &defaultReviewer, nil
+}
+
+func (c *Client) CreateProjectDefaultReviewer(projectKey, user string) (*User, error) {
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/workspaces/%s/projects/%s/default-reviewers/%s", c.Host, c.Workspace, projectKey, url.PathEscape(user)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var defaultReviewer User
+	err = json.Unmarshal(body, &defaultReviewer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &defaultReviewer, nil
+}
+
+func (c *Client) DeleteProjectDefaultReviewer(projectKey, user string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/workspaces/%s/projects/%s/default-reviewers/%s", c.Host, c.Workspace, projectKey, url.PathEscape(user)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
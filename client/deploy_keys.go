@@ -0,0 +1,94 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetDeployKey(repositorySlug string, id int) (*DeployKey, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/repositories/%s/%s/deploy-keys/%d", c.Host, c.Workspace, repositorySlug, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var deployKey DeployKey
+	err = json.Unmarshal(body, &deployKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deployKey, nil
+}
+
+func (c *Client) CreateDeployKey(repositorySlug string, newDeployKey DeployKey) (*DeployKey, error) {
+	rb, err := json.Marshal(newDeployKey)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/repositories/%s/%s/deploy-keys", c.Host, c.Workspace, repositorySlug), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var deployKey DeployKey
+	err = json.Unmarshal(body, &deployKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deployKey, nil
+}
+
+func (c *Client) UpdateDeployKey(repositorySlug string, id int, newDeployKey DeployKey) (*DeployKey, error) {
+	rb, err := json.Marshal(newDeployKey)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/repositories/%s/%s/deploy-keys/%d", c.Host, c.Workspace, repositorySlug, id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var deployKey DeployKey
+	err = json.Unmarshal(body, &deployKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deployKey, nil
+}
+
+func (c *Client) DeleteDeployKey(repositorySlug string, id int) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/repositories/%s/%s/deploy-keys/%d", c.Host, c.Workspace, repositorySlug, id), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func (c *Client) GetDeployment(repositorySlug, uuid string) (*Deployment, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/repositories/%s/%s/environments/%s", c.Host, c.Workspace, repositorySlug, url.PathEscape(uuid)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var deployment Deployment
+	err = json.Unmarshal(body, &deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deployment, nil
+}
+
+func (c *Client) CreateDeployment(repositorySlug string, newDeployment Deployment) (*Deployment, error) {
+	rb, err := json.Marshal(newDeployment)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/repositories/%s/%s/environments", c.Host, c.Workspace, repositorySlug), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var deployment Deployment
+	err = json.Unmarshal(body, &deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deployment, nil
+}
+
+// UpdateDeployment submits a change document for an environment. Bitbucket
+// applies it asynchronously and does not return the updated environment.
+func (c *Client) UpdateDeployment(repositorySlug, uuid string, change DeploymentChange) error {
+	rb, err := json.Marshal(struct {
+		Change DeploymentChange `json:"change"`
+	}{change})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/repositories/%s/%s/environments/%s/changes", c.Host, c.Workspace, repositorySlug, url.PathEscape(uuid)), strings.NewReader(string(rb)))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteDeployment(repositorySlug, uuid string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/repositories/%s/%s/environments/%s", c.Host, c.Workspace, repositorySlug, url.PathEscape(uuid)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) GetDeploymentVariables(repositorySlug, deploymentUuid string) (*Paginated[PipelineVariable], error) {
+	return getAllPages[PipelineVariable](c, fmt.Sprintf("%s/repositories/%s/%s/deployments_config/environments/%s/variables", c.Host, c.Workspace, repositorySlug, url.PathEscape(deploymentUuid)))
+}
+
+func (c *Client) CreateDeploymentVariable(repositorySlug, deploymentUuid string, newPipelineVariable PipelineVariable) (*PipelineVariable, error) {
+	rb, err := json.Marshal(newPipelineVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/repositories/%s/%s/deployments_config/environments/%s/variables", c.Host, c.Workspace, repositorySlug, url.PathEscape(deploymentUuid)), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineVariable PipelineVariable
+	err = json.Unmarshal(body, &pipelineVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineVariable, nil
+}
+
+func (c *Client) UpdateDeploymentVariable(repositorySlug, deploymentUuid, uuid string, newPipelineVariable PipelineVariable) (*PipelineVariable, error) {
+	rb, err := json.Marshal(newPipelineVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/repositories/%s/%s/deployments_config/environments/%s/variables/%s", c.Host, c.Workspace, repositorySlug, url.PathEscape(deploymentUuid), url.PathEscape(uuid)), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineVariable PipelineVariable
+	err = json.Unmarshal(body, &pipelineVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineVariable, nil
+}
+
+func (c *Client) DeleteDeploymentVariable(repositorySlug, deploymentUuid, uuid string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/repositories/%s/%s/deployments_config/environments/%s/variables/%s", c.Host, c.Workspace, repositorySlug, url.PathEscape(deploymentUuid), url.PathEscape(uuid)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -5,7 +5,8 @@ type Project struct {
 }
 
 type Paginated[T any] struct {
-	Values []T `json:"values"`
+	Values []T    `json:"values"`
+	Next   string `json:"next"`
 }
 
 type Repository struct {
@@ -17,7 +18,10 @@ type Repository struct {
 }
 
 type User struct {
-	Uuid string `json:"uuid"`
+	Uuid        string `json:"uuid"`
+	AccountID   string `json:"account_id,omitempty"`
+	Nickname    string `json:"nickname,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
 }
 
 type Group struct {
@@ -28,7 +32,9 @@ type BranchRestriction struct {
 	ID              int     `json:"id"`
 	Kind            string  `json:"kind"`
 	BranchMatchKind string  `json:"branch_match_kind"`
+	BranchType      *string `json:"branch_type,omitempty"`
 	Pattern         string  `json:"pattern"`
+	Value           *int64  `json:"value,omitempty"`
 	Users           []User  `json:"users"`
 	Groups          []Group `json:"groups"`
 }
@@ -36,3 +42,190 @@ type BranchRestriction struct {
 type GroupPermission struct {
 	Permission string `json:"permission"`
 }
+
+type WorkspaceMembership struct {
+	User User `json:"user"`
+}
+
+type Link struct {
+	Href string `json:"href"`
+}
+
+type WorkspaceLinks struct {
+	Self   Link `json:"self"`
+	Html   Link `json:"html"`
+	Avatar Link `json:"avatar"`
+}
+
+type Workspace struct {
+	Uuid      string         `json:"uuid"`
+	Slug      string         `json:"slug"`
+	Name      string         `json:"name"`
+	IsPrivate bool           `json:"is_private"`
+	Links     WorkspaceLinks `json:"links"`
+}
+
+type WorkspacePermission struct {
+	Permission string    `json:"permission"`
+	User       User      `json:"user"`
+	Workspace  Workspace `json:"workspace"`
+}
+
+type DeployKey struct {
+	ID    int    `json:"id,omitempty"`
+	Key   string `json:"key"`
+	Label string `json:"label"`
+}
+
+type Webhook struct {
+	Uuid        string   `json:"uuid,omitempty"`
+	Url         string   `json:"url"`
+	Description string   `json:"description"`
+	Active      bool     `json:"active"`
+	Events      []string `json:"events"`
+	Secret      *string  `json:"secret,omitempty"`
+}
+
+type BranchingModelBranch struct {
+	Name          *string `json:"name,omitempty"`
+	UseMainbranch bool    `json:"use_mainbranch"`
+	Enabled       *bool   `json:"enabled,omitempty"`
+}
+
+type BranchType struct {
+	Kind    string `json:"kind"`
+	Prefix  string `json:"prefix,omitempty"`
+	Enabled bool   `json:"enabled"`
+}
+
+type BranchingModelSettings struct {
+	Development BranchingModelBranch `json:"development"`
+	Production  BranchingModelBranch `json:"production"`
+	BranchTypes []BranchType         `json:"branch_types"`
+}
+
+type PipelinesConfig struct {
+	Enabled bool `json:"enabled"`
+}
+
+type PipelineVariable struct {
+	Uuid    string `json:"uuid,omitempty"`
+	Key     string `json:"key"`
+	Value   string `json:"value,omitempty"`
+	Secured bool   `json:"secured"`
+}
+
+type DeploymentEnvironmentType struct {
+	Name string `json:"name"`
+}
+
+type DeploymentRestrictions struct {
+	AdminOnly bool `json:"admin_only"`
+}
+
+type Deployment struct {
+	Uuid            string                    `json:"uuid,omitempty"`
+	Name            string                    `json:"name"`
+	EnvironmentType DeploymentEnvironmentType `json:"environment_type"`
+	Restrictions    DeploymentRestrictions    `json:"restrictions"`
+}
+
+type PipelineScheduleSelector struct {
+	Type    string `json:"type"`
+	Pattern string `json:"pattern"`
+}
+
+type PipelineScheduleTarget struct {
+	Type     string                   `json:"type"`
+	RefType  string                   `json:"ref_type"`
+	RefName  string                   `json:"ref_name"`
+	Selector PipelineScheduleSelector `json:"selector"`
+}
+
+type PipelineSchedule struct {
+	Uuid        string                  `json:"uuid,omitempty"`
+	Enabled     bool                    `json:"enabled"`
+	CronPattern string                  `json:"cron_pattern,omitempty"`
+	Target      *PipelineScheduleTarget `json:"target,omitempty"`
+}
+
+type PipelineSshKeyPair struct {
+	PrivateKey string `json:"private_key,omitempty"`
+	PublicKey  string `json:"public_key,omitempty"`
+}
+
+type PipelineSshPublicKey struct {
+	KeyType string `json:"key_type"`
+	Key     string `json:"key"`
+}
+
+type PipelineKnownHost struct {
+	Uuid      string               `json:"uuid,omitempty"`
+	Hostname  string               `json:"hostname"`
+	PublicKey PipelineSshPublicKey `json:"public_key"`
+}
+
+type PipelinesOidcConfiguration struct {
+	Issuer  string `json:"issuer"`
+	JwksUri string `json:"jwks_uri"`
+}
+
+type PipelinesOidcKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+type PipelinesOidcKeys struct {
+	Keys []PipelinesOidcKey `json:"keys"`
+
+	// Raw is the key set exactly as Bitbucket published it, including any
+	// members that are not decoded into Keys.
+	Raw string `json:"-"`
+}
+
+type PipelineRunnerState struct {
+	Status string `json:"status"`
+}
+
+type PipelineRunnerOauthClient struct {
+	ID     string `json:"id"`
+	Secret string `json:"secret,omitempty"`
+}
+
+type PipelineRunner struct {
+	Uuid        string                     `json:"uuid,omitempty"`
+	Name        string                     `json:"name"`
+	Labels      []string                   `json:"labels"`
+	State       *PipelineRunnerState       `json:"state,omitempty"`
+	OauthClient *PipelineRunnerOauthClient `json:"oauth_client,omitempty"`
+}
+
+type SshKey struct {
+	Uuid    string `json:"uuid,omitempty"`
+	Key     string `json:"key"`
+	Label   string `json:"label"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type AccessToken struct {
+	ID        string   `json:"id,omitempty"`
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	ExpiresAt string   `json:"expires_at,omitempty"`
+	Token     string   `json:"token,omitempty"`
+}
+
+type PullRequestSettings struct {
+	MergeStrategies              []string `json:"merge_strategies"`
+	DefaultMergeStrategy         string   `json:"default_merge_strategy,omitempty"`
+	DeleteSourceBranchAfterMerge bool     `json:"delete_source_branch_after_merge"`
+}
+
+type DeploymentChange struct {
+	Name         string                  `json:"name,omitempty"`
+	Restrictions *DeploymentRestrictions `json:"restrictions,omitempty"`
+}
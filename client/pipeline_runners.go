@@ -0,0 +1,105 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// pipelineRunnersURL returns the runners endpoint of a repository, or of the
+// workspace when repositorySlug is empty.
+func (c *Client) pipelineRunnersURL(repositorySlug string) string {
+	if repositorySlug == "" {
+		return fmt.Sprintf("%s/workspaces/%s/pipelines-config/runners", c.Host, c.Workspace)
+	}
+
+	return fmt.Sprintf("%s/repositories/%s/%s/pipelines-config/runners", c.Host, c.Workspace, repositorySlug)
+}
+
+func (c *Client) GetPipelineRunner(repositorySlug, uuid string) (*PipelineRunner, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/%s", c.pipelineRunnersURL(repositorySlug), url.PathEscape(uuid)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineRunner PipelineRunner
+	err = json.Unmarshal(body, &pipelineRunner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineRunner, nil
+}
+
+func (c *Client) CreatePipelineRunner(repositorySlug string, newPipelineRunner PipelineRunner) (*PipelineRunner, error) {
+	rb, err := json.Marshal(newPipelineRunner)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", c.pipelineRunnersURL(repositorySlug), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineRunner PipelineRunner
+	err = json.Unmarshal(body, &pipelineRunner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineRunner, nil
+}
+
+func (c *Client) UpdatePipelineRunner(repositorySlug, uuid string, newPipelineRunner PipelineRunner) (*PipelineRunner, error) {
+	rb, err := json.Marshal(newPipelineRunner)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/%s", c.pipelineRunnersURL(repositorySlug), url.PathEscape(uuid)), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineRunner PipelineRunner
+	err = json.Unmarshal(body, &pipelineRunner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineRunner, nil
+}
+
+func (c *Client) DeletePipelineRunner(repositorySlug, uuid string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/%s", c.pipelineRunnersURL(repositorySlug), url.PathEscape(uuid)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func (c *Client) GetPipelineSchedule(repositorySlug, uuid string) (*PipelineSchedule, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/repositories/%s/%s/pipelines_config/schedules/%s", c.Host, c.Workspace, repositorySlug, url.PathEscape(uuid)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineSchedule PipelineSchedule
+	err = json.Unmarshal(body, &pipelineSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineSchedule, nil
+}
+
+func (c *Client) CreatePipelineSchedule(repositorySlug string, newPipelineSchedule PipelineSchedule) (*PipelineSchedule, error) {
+	rb, err := json.Marshal(newPipelineSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/repositories/%s/%s/pipelines_config/schedules", c.Host, c.Workspace, repositorySlug), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineSchedule PipelineSchedule
+	err = json.Unmarshal(body, &pipelineSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineSchedule, nil
+}
+
+func (c *Client) UpdatePipelineSchedule(repositorySlug, uuid string, newPipelineSchedule PipelineSchedule) (*PipelineSchedule, error) {
+	rb, err := json.Marshal(newPipelineSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/repositories/%s/%s/pipelines_config/schedules/%s", c.Host, c.Workspace, repositorySlug, url.PathEscape(uuid)), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineSchedule PipelineSchedule
+	err = json.Unmarshal(body, &pipelineSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineSchedule, nil
+}
+
+func (c *Client) DeletePipelineSchedule(repositorySlug, uuid string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/repositories/%s/%s/pipelines_config/schedules/%s", c.Host, c.Workspace, repositorySlug, url.PathEscape(uuid)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
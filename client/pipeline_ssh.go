@@ -0,0 +1,155 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func (c *Client) GetPipelineSshKeyPair(repositorySlug string) (*PipelineSshKeyPair, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/repositories/%s/%s/pipelines_config/ssh/key_pair", c.Host, c.Workspace, repositorySlug), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineSshKeyPair PipelineSshKeyPair
+	err = json.Unmarshal(body, &pipelineSshKeyPair)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineSshKeyPair, nil
+}
+
+func (c *Client) UpdatePipelineSshKeyPair(repositorySlug string, newPipelineSshKeyPair PipelineSshKeyPair) (*PipelineSshKeyPair, error) {
+	rb, err := json.Marshal(newPipelineSshKeyPair)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/repositories/%s/%s/pipelines_config/ssh/key_pair", c.Host, c.Workspace, repositorySlug), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineSshKeyPair PipelineSshKeyPair
+	err = json.Unmarshal(body, &pipelineSshKeyPair)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineSshKeyPair, nil
+}
+
+func (c *Client) DeletePipelineSshKeyPair(repositorySlug string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/repositories/%s/%s/pipelines_config/ssh/key_pair", c.Host, c.Workspace, repositorySlug), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) GetPipelineKnownHost(repositorySlug, uuid string) (*PipelineKnownHost, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/repositories/%s/%s/pipelines_config/ssh/known_hosts/%s", c.Host, c.Workspace, repositorySlug, url.PathEscape(uuid)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineKnownHost PipelineKnownHost
+	err = json.Unmarshal(body, &pipelineKnownHost)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineKnownHost, nil
+}
+
+func (c *Client) CreatePipelineKnownHost(repositorySlug string, newPipelineKnownHost PipelineKnownHost) (*PipelineKnownHost, error) {
+	rb, err := json.Marshal(newPipelineKnownHost)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/repositories/%s/%s/pipelines_config/ssh/known_hosts", c.Host, c.Workspace, repositorySlug), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineKnownHost PipelineKnownHost
+	err = json.Unmarshal(body, &pipelineKnownHost)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineKnownHost, nil
+}
+
+func (c *Client) UpdatePipelineKnownHost(repositorySlug, uuid string, newPipelineKnownHost PipelineKnownHost) (*PipelineKnownHost, error) {
+	rb, err := json.Marshal(newPipelineKnownHost)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/repositories/%s/%s/pipelines_config/ssh/known_hosts/%s", c.Host, c.Workspace, repositorySlug, url.PathEscape(uuid)), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineKnownHost PipelineKnownHost
+	err = json.Unmarshal(body, &pipelineKnownHost)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineKnownHost, nil
+}
+
+func (c *Client) DeletePipelineKnownHost(repositorySlug, uuid string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/repositories/%s/%s/pipelines_config/ssh/known_hosts/%s", c.Host, c.Workspace, repositorySlug, url.PathEscape(uuid)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func (c *Client) GetRepositoryVariable(repositorySlug, uuid string) (*PipelineVariable, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/repositories/%s/%s/pipelines_config/variables/%s", c.Host, c.Workspace, repositorySlug, url.PathEscape(uuid)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineVariable PipelineVariable
+	err = json.Unmarshal(body, &pipelineVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineVariable, nil
+}
+
+func (c *Client) CreateRepositoryVariable(repositorySlug string, newPipelineVariable PipelineVariable) (*PipelineVariable, error) {
+	rb, err := json.Marshal(newPipelineVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/repositories/%s/%s/pipelines_config/variables", c.Host, c.Workspace, repositorySlug), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineVariable PipelineVariable
+	err = json.Unmarshal(body, &pipelineVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineVariable, nil
+}
+
+func (c *Client) UpdateRepositoryVariable(repositorySlug, uuid string, newPipelineVariable PipelineVariable) (*PipelineVariable, error) {
+	rb, err := json.Marshal(newPipelineVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/repositories/%s/%s/pipelines_config/variables/%s", c.Host, c.Workspace, repositorySlug, url.PathEscape(uuid)), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineVariable PipelineVariable
+	err = json.Unmarshal(body, &pipelineVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineVariable, nil
+}
+
+func (c *Client) DeleteRepositoryVariable(repositorySlug, uuid string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/repositories/%s/%s/pipelines_config/variables/%s", c.Host, c.Workspace, repositorySlug, url.PathEscape(uuid)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) GetWorkspaceVariable(uuid string) (*PipelineVariable, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/workspaces/%s/pipelines-config/variables/%s", c.Host, c.Workspace, url.PathEscape(uuid)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineVariable PipelineVariable
+	err = json.Unmarshal(body, &pipelineVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineVariable, nil
+}
+
+func (c *Client) CreateWorkspaceVariable(newPipelineVariable PipelineVariable) (*PipelineVariable, error) {
+	rb, err := json.Marshal(newPipelineVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/workspaces/%s/pipelines-config/variables", c.Host, c.Workspace), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineVariable PipelineVariable
+	err = json.Unmarshal(body, &pipelineVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineVariable, nil
+}
+
+func (c *Client) UpdateWorkspaceVariable(uuid string, newPipelineVariable PipelineVariable) (*PipelineVariable, error) {
+	rb, err := json.Marshal(newPipelineVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/workspaces/%s/pipelines-config/variables/%s", c.Host, c.Workspace, url.PathEscape(uuid)), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineVariable PipelineVariable
+	err = json.Unmarshal(body, &pipelineVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineVariable, nil
+}
+
+func (c *Client) DeleteWorkspaceVariable(uuid string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/workspaces/%s/pipelines-config/variables/%s", c.Host, c.Workspace, url.PathEscape(uuid)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
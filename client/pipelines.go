@@ -0,0 +1,54 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetPipelinesConfig(repositorySlug string) (*PipelinesConfig, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/repositories/%s/%s/pipelines_config", c.Host, c.Workspace, repositorySlug), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelinesConfig PipelinesConfig
+	err = json.Unmarshal(body, &pipelinesConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelinesConfig, nil
+}
+
+func (c *Client) UpdatePipelinesConfig(repositorySlug string, newPipelinesConfig PipelinesConfig) (*PipelinesConfig, error) {
+	rb, err := json.Marshal(newPipelinesConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/repositories/%s/%s/pipelines_config", c.Host, c.Workspace, repositorySlug), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelinesConfig PipelinesConfig
+	err = json.Unmarshal(body, &pipelinesConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelinesConfig, nil
+}
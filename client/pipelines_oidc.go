@@ -0,0 +1,49 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) GetPipelinesOidcConfiguration() (*PipelinesOidcConfiguration, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/workspaces/%s/pipelines-config/identity/oidc/.well-known/openid-configuration", c.Host, c.Workspace), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelinesOidcConfiguration PipelinesOidcConfiguration
+	err = json.Unmarshal(body, &pipelinesOidcConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelinesOidcConfiguration, nil
+}
+
+func (c *Client) GetPipelinesOidcKeys() (*PipelinesOidcKeys, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/workspaces/%s/pipelines-config/identity/oidc/keys.json", c.Host, c.Workspace), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelinesOidcKeys PipelinesOidcKeys
+	err = json.Unmarshal(body, &pipelinesOidcKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	pipelinesOidcKeys.Raw = string(body)
+
+	return &pipelinesOidcKeys, nil
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetPullRequestSettings(repositorySlug string) (*PullRequestSettings, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/repositories/%s/%s/pullrequests/settings", c.Host, c.Workspace, repositorySlug), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pullRequestSettings PullRequestSettings
+	err = json.Unmarshal(body, &pullRequestSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pullRequestSettings, nil
+}
+
+func (c *Client) UpdatePullRequestSettings(repositorySlug string, newPullRequestSettings PullRequestSettings) (*PullRequestSettings, error) {
+	rb, err := json.Marshal(newPullRequestSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/repositories/%s/%s/pullrequests/settings", c.Host, c.Workspace, repositorySlug), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var pullRequestSettings PullRequestSettings
+	err = json.Unmarshal(body, &pullRequestSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pullRequestSettings, nil
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func (c *Client) GetUserSshKeys(selectedUser string) (*Paginated[SshKey], error) {
+	return getAllPages[SshKey](c, fmt.Sprintf("%s/users/%s/ssh-keys", c.Host, url.PathEscape(selectedUser)))
+}
+
+func (c *Client) GetUserSshKey(selectedUser, uuid string) (*SshKey, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/users/%s/ssh-keys/%s", c.Host, url.PathEscape(selectedUser), url.PathEscape(uuid)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var sshKey SshKey
+	err = json.Unmarshal(body, &sshKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sshKey, nil
+}
+
+func (c *Client) CreateUserSshKey(selectedUser string, newSshKey SshKey) (*SshKey, error) {
+	rb, err := json.Marshal(newSshKey)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/users/%s/ssh-keys", c.Host, url.PathEscape(selectedUser)), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var sshKey SshKey
+	err = json.Unmarshal(body, &sshKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sshKey, nil
+}
+
+func (c *Client) UpdateUserSshKey(selectedUser, uuid string, newSshKey SshKey) (*SshKey, error) {
+	rb, err := json.Marshal(newSshKey)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/users/%s/ssh-keys/%s", c.Host, url.PathEscape(selectedUser), url.PathEscape(uuid)), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var sshKey SshKey
+	err = json.Unmarshal(body, &sshKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sshKey, nil
+}
+
+func (c *Client) DeleteUserSshKey(selectedUser, uuid string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/users/%s/ssh-keys/%s", c.Host, url.PathEscape(selectedUser), url.PathEscape(uuid)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) GetCurrentUser() (*User, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/user", c.Host), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var user User
+	err = json.Unmarshal(body, &user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (c *Client) GetCurrentUserWorkspaces() (*Paginated[WorkspacePermission], error) {
+	return getAllPages[WorkspacePermission](c, fmt.Sprintf("%s/user/permissions/workspaces", c.Host))
+}
+
+func (c *Client) GetUser(selectedUser string) (*User, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/users/%s", c.Host, url.PathEscape(selectedUser)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var user User
+	err = json.Unmarshal(body, &user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
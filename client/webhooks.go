@@ -0,0 +1,105 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// webhooksURL returns the hooks endpoint of a repository, or of the workspace
+// when repositorySlug is empty.
+func (c *Client) webhooksURL(repositorySlug string) string {
+	if repositorySlug == "" {
+		return fmt.Sprintf("%s/workspaces/%s/hooks", c.Host, c.Workspace)
+	}
+
+	return fmt.Sprintf("%s/repositories/%s/%s/hooks", c.Host, c.Workspace, repositorySlug)
+}
+
+func (c *Client) GetWebhook(repositorySlug, uuid string) (*Webhook, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/%s", c.webhooksURL(repositorySlug), url.PathEscape(uuid)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhook Webhook
+	err = json.Unmarshal(body, &webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+func (c *Client) CreateWebhook(repositorySlug string, newWebhook Webhook) (*Webhook, error) {
+	rb, err := json.Marshal(newWebhook)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", c.webhooksURL(repositorySlug), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhook Webhook
+	err = json.Unmarshal(body, &webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+func (c *Client) UpdateWebhook(repositorySlug, uuid string, newWebhook Webhook) (*Webhook, error) {
+	rb, err := json.Marshal(newWebhook)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/%s", c.webhooksURL(repositorySlug), url.PathEscape(uuid)), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhook Webhook
+	err = json.Unmarshal(body, &webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+func (c *Client) DeleteWebhook(repositorySlug, uuid string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/%s", c.webhooksURL(repositorySlug), url.PathEscape(uuid)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) GetWorkspace(slug string) (*Workspace, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/workspaces/%s", c.Host, url.PathEscape(slug)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var workspace Workspace
+	err = json.Unmarshal(body, &workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workspace, nil
+}
+
+func (c *Client) GetWorkspaceMembers() (*Paginated[WorkspaceMembership], error) {
+	return getAllPages[WorkspaceMembership](c, fmt.Sprintf("%s/workspaces/%s/members", c.Host, c.Workspace))
+}
+
+func (c *Client) GetWorkspaceMembersByEmail(email string) (*Paginated[WorkspaceMembership], error) {
+	query := url.Values{}
+	query.Set("q", fmt.Sprintf("user.email IN (%q)", email))
+
+	return getAllPages[WorkspaceMembership](c, fmt.Sprintf("%s/workspaces/%s/members?%s", c.Host, c.Workspace, query.Encode()))
+}
+
+func (c *Client) GetWorkspacePermissions() (*Paginated[WorkspacePermission], error) {
+	return getAllPages[WorkspacePermission](c, fmt.Sprintf("%s/workspaces/%s/permissions", c.Host, c.Workspace))
+}
+
+func (c *Client) GetWorkspaceMember(member string) (*WorkspaceMembership, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/workspaces/%s/members/%s", c.Host, c.Workspace, url.PathEscape(member)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var workspaceMembership WorkspaceMembership
+	err = json.Unmarshal(body, &workspaceMembership)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workspaceMembership, nil
+}
@@ -7,8 +7,9 @@ terraform {
 }
 
 provider "bitbucket" {
-  host      = "https://api.bitbucket.org/2.0"
-  workspace = "afagund"
+  host             = "https://api.bitbucket.org/2.0"
+  workspace        = "afagund"
+  verify_workspace = true
 }
 
 data "bitbucket_repository" "this" {
@@ -58,6 +59,9 @@ resource "bitbucket_branch_restriction" "demo" {
   users = [
     {
       uuid = "{115d8671-697c-4a7e-8958-c948613e3a79}"
+    },
+    {
+      email = "jane.doe@example.com"
     }
   ]
 
@@ -74,3 +78,287 @@ resource "bitbucket_branch_restriction" "demo" {
     bitbucket_repository.demo
   ]
 }
+
+data "bitbucket_branch_restrictions" "demo" {
+  repository_slug = "demo"
+  kind            = "push"
+}
+
+output "bitbucket_branch_restrictions" {
+  value = data.bitbucket_branch_restrictions.demo.restrictions
+}
+
+resource "bitbucket_repository_branch_restrictions" "demo" {
+  repository_slug = "demo"
+
+  restrictions = [
+    {
+      kind              = "delete"
+      branch_match_kind = "glob"
+      pattern           = "main"
+    },
+    {
+      kind              = "require_approvals_to_merge"
+      branch_match_kind = "glob"
+      pattern           = "main"
+      value             = 2
+    },
+  ]
+
+  depends_on = [
+    bitbucket_repository.demo
+  ]
+}
+
+data "bitbucket_user" "jane" {
+  nickname = "jane.doe"
+}
+
+output "bitbucket_user" {
+  value = data.bitbucket_user.jane.uuid
+}
+
+data "bitbucket_current_user" "this" {}
+
+output "bitbucket_current_user" {
+  value = data.bitbucket_current_user.this
+}
+
+data "bitbucket_workspace_members" "owners" {
+  permission = "owner"
+}
+
+resource "bitbucket_branch_restriction" "admins_only" {
+  repository_slug   = "demo"
+  kind              = "restrict_merges"
+  branch_match_kind = "glob"
+  pattern           = "release/*"
+
+  users = [
+    for member in data.bitbucket_workspace_members.owners.members : {
+      uuid = member.uuid
+    }
+  ]
+
+  depends_on = [
+    bitbucket_repository.demo
+  ]
+}
+
+data "bitbucket_workspace" "this" {}
+
+output "bitbucket_workspace" {
+  value = data.bitbucket_workspace.this
+}
+
+resource "bitbucket_repository" "other_workspace" {
+  workspace  = "afagund-labs"
+  slug       = "demo"
+  is_private = true
+  scm        = "git"
+
+  project = {
+    key : "LAB"
+  }
+}
+
+resource "bitbucket_deploy_key" "deploy" {
+  repository_slug = "demo"
+  label           = "deploy-server"
+  key             = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGJX6vEjCmWg2XTkB4hMfm1t0iEc0PahZB5p9yXbWJt4 deploy@example.com"
+
+  depends_on = [
+    bitbucket_repository.demo
+  ]
+}
+
+resource "bitbucket_webhook" "ci" {
+  repository_slug = "demo"
+  url             = "https://ci.example.com/bitbucket/hook"
+  description     = "CI"
+  secret          = "change-me"
+
+  events = [
+    "repo:push",
+    "pullrequest:created",
+    "pullrequest:updated",
+  ]
+
+  depends_on = [
+    bitbucket_repository.demo
+  ]
+}
+
+resource "bitbucket_webhook" "chat" {
+  url         = "https://chat.example.com/hooks/bitbucket"
+  description = "Chat notifications"
+
+  events = [
+    "repo:created",
+    "pullrequest:fulfilled",
+  ]
+}
+
+resource "bitbucket_default_reviewer" "jane" {
+  repository_slug = "demo"
+  user            = data.bitbucket_user.jane.uuid
+
+  depends_on = [
+    bitbucket_repository.demo
+  ]
+}
+
+resource "bitbucket_default_reviewers" "owners" {
+  repository_slug = "other"
+  users           = [for member in data.bitbucket_workspace_members.owners.members : member.uuid]
+}
+
+resource "bitbucket_project_default_reviewer" "jane" {
+  project_key = "INT"
+  user        = data.bitbucket_user.jane.uuid
+}
+
+resource "bitbucket_branching_model" "demo" {
+  repository_slug = "demo"
+
+  development = {
+    name = "develop"
+  }
+
+  production = {
+    use_mainbranch = true
+  }
+
+  branch_types = [
+    {
+      kind   = "feature"
+      prefix = "feature/"
+    },
+    {
+      kind   = "release"
+      prefix = "release/"
+    },
+    {
+      kind   = "hotfix"
+      prefix = "hotfix/"
+    },
+  ]
+
+  depends_on = [
+    bitbucket_repository.demo
+  ]
+}
+
+resource "bitbucket_project_branching_model" "int" {
+  project_key = "INT"
+
+  development = {
+    use_mainbranch = true
+  }
+
+  branch_types = [
+    {
+      kind   = "feature"
+      prefix = "feature/"
+    },
+  ]
+}
+
+resource "bitbucket_pipelines_config" "demo" {
+  repository_slug = bitbucket_repository.demo.slug
+  enabled         = true
+}
+
+resource "bitbucket_repository_variable" "registry_token" {
+  repository_slug = bitbucket_repository.demo.slug
+  key             = "REGISTRY_TOKEN"
+  value           = "change-me"
+  secured         = true
+}
+
+resource "bitbucket_workspace_variable" "sonar_token" {
+  key     = "SONAR_TOKEN"
+  value   = "change-me"
+  secured = true
+}
+
+resource "bitbucket_deployment" "staging" {
+  repository_slug = bitbucket_repository.demo.slug
+  name            = "staging"
+  stage           = "Staging"
+}
+
+resource "bitbucket_deployment_variable" "api_url" {
+  repository_slug = bitbucket_repository.demo.slug
+  deployment      = bitbucket_deployment.staging.uuid
+  key             = "API_URL"
+  value           = "https://staging.example.com"
+}
+
+resource "bitbucket_pipeline_schedule" "nightly" {
+  repository_slug  = bitbucket_repository.demo.slug
+  ref_name         = "main"
+  selector_pattern = "main"
+  cron_pattern     = "0 0 2 * * ? *"
+}
+
+resource "bitbucket_pipeline_ssh_key" "deploy" {
+  repository_slug = bitbucket_repository.demo.slug
+  private_key     = file("~/.ssh/pipelines_deploy")
+  public_key      = file("~/.ssh/pipelines_deploy.pub")
+}
+
+resource "bitbucket_pipeline_known_host" "deploy" {
+  repository_slug = bitbucket_repository.demo.slug
+  hostname        = "deploy.example.com"
+  public_key      = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIExampleExampleExampleExampleExampleExample"
+}
+
+data "bitbucket_pipelines_oidc_config" "this" {}
+
+output "bitbucket_pipelines_oidc_config" {
+  value = {
+    issuer_url = data.bitbucket_pipelines_oidc_config.this.issuer_url
+    audience   = data.bitbucket_pipelines_oidc_config.this.audience
+  }
+}
+
+resource "bitbucket_pipeline_runner" "linux" {
+  name   = "linux-runner"
+  labels = ["self.hosted", "linux"]
+}
+
+resource "bitbucket_user_ssh_key" "ci" {
+  user  = data.bitbucket_current_user.this.uuid
+  key   = file("~/.ssh/ci_bot.pub")
+  label = "ci-bot"
+}
+
+data "bitbucket_user_ssh_keys" "ci" {
+  user = data.bitbucket_current_user.this.uuid
+}
+
+resource "bitbucket_repository_access_token" "ci" {
+  repository_slug = bitbucket_repository.demo.slug
+  name            = "ci"
+  scopes          = ["repository", "pullrequest"]
+}
+
+resource "bitbucket_project_access_token" "release" {
+  project_key = "INT"
+  name        = "release"
+  scopes      = ["repository:write"]
+  expires_at  = "2027-01-01T00:00:00Z"
+}
+
+resource "bitbucket_workspace_access_token" "audit" {
+  name   = "audit"
+  scopes = ["account", "project"]
+}
+
+resource "bitbucket_repository_pull_request_settings" "demo" {
+  repository_slug                  = bitbucket_repository.demo.slug
+  merge_strategies                 = ["squash"]
+  default_merge_strategy           = "squash"
+  delete_source_branch_after_merge = true
+}
@@ -2,7 +2,10 @@ module github.com/afagund/terraform-provider-bitbucket
 
 go 1.20
 
-require github.com/hashicorp/terraform-plugin-framework v1.5.0
+require (
+	github.com/hashicorp/terraform-plugin-framework v1.5.0
+	github.com/hashicorp/terraform-plugin-log v0.9.0
+)
 
 require (
 	github.com/fatih/color v1.13.0 // indirect
@@ -11,7 +14,6 @@ require (
 	github.com/hashicorp/go-plugin v1.6.0 // indirect
 	github.com/hashicorp/go-uuid v1.0.3 // indirect
 	github.com/hashicorp/terraform-plugin-go v0.20.0 // indirect
-	github.com/hashicorp/terraform-plugin-log v0.9.0 // indirect
 	github.com/hashicorp/terraform-registry-address v0.2.3 // indirect
 	github.com/hashicorp/terraform-svchost v0.1.1 // indirect
 	github.com/hashicorp/yamux v0.1.1 // indirect
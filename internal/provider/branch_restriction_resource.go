@@ -16,16 +16,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
 	_ resource.Resource                = &branchRestrictionResource{}
 	_ resource.ResourceWithConfigure   = &branchRestrictionResource{}
 	_ resource.ResourceWithImportState = &branchRestrictionResource{}
+	_ resource.ResourceWithModifyPlan  = &branchRestrictionResource{}
 )
 
 type userModel struct {
-	Uuid types.String `tfsdk:"uuid"`
+	Uuid      types.String `tfsdk:"uuid"`
+	AccountID types.String `tfsdk:"account_id"`
+	Nickname  types.String `tfsdk:"nickname"`
+	Email     types.String `tfsdk:"email"`
 }
 
 type groupModel struct {
@@ -33,10 +38,12 @@ type groupModel struct {
 }
 
 type branchRestrictionResourceModel struct {
+	Workspace       types.String `tfsdk:"workspace"`
 	ID              types.Int64  `tfsdk:"id"`
 	RepositorySlug  types.String `tfsdk:"repository_slug"`
 	Kind            types.String `tfsdk:"kind"`
 	BranchMatchKind types.String `tfsdk:"branch_match_kind"`
+	BranchType      types.String `tfsdk:"branch_type"`
 	Pattern         types.String `tfsdk:"pattern"`
 	Users           []userModel  `tfsdk:"users"`
 	Groups          []groupModel `tfsdk:"groups"`
@@ -47,7 +54,8 @@ func NewBranchRestrictionResource() resource.Resource {
 }
 
 type branchRestrictionResource struct {
-	client *client.Client
+	client        *client.Client
+	adoptExisting bool
 }
 
 func (r *branchRestrictionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -57,6 +65,10 @@ func (r *branchRestrictionResource) Metadata(_ context.Context, req resource.Met
 func (r *branchRestrictionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
 			"id": schema.Int64Attribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.Int64{
@@ -75,18 +87,34 @@ func (r *branchRestrictionResource) Schema(_ context.Context, _ resource.SchemaR
 			"branch_match_kind": schema.StringAttribute{
 				Required: true,
 			},
+			"branch_type": schema.StringAttribute{
+				Optional: true,
+			},
 			"pattern": schema.StringAttribute{
 				Required: true,
 			},
 			"users": schema.ListNestedAttribute{
 				Optional: true,
 				PlanModifiers: []planmodifier.List{
+					usersUseStateForUnknown(),
 					listplanmodifier.RequiresReplace(),
 				},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"uuid": schema.StringAttribute{
-							Required: true,
+							Optional: true,
+							Computed: true,
+						},
+						"account_id": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+						},
+						"nickname": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+						},
+						"email": schema.StringAttribute{
+							Optional: true,
 						},
 					},
 				},
@@ -108,6 +136,10 @@ func (r *branchRestrictionResource) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
+func (r *branchRestrictionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
 func (r *branchRestrictionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan branchRestrictionResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -116,10 +148,49 @@ func (r *branchRestrictionResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	err := plan.resolveUsers(r.client.WithWorkspace(plan.Workspace.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating branch restriction",
+			"Could not resolve branch restriction users, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
 	var newBranchRestriction client.BranchRestriction
 	plan.mapTo(&newBranchRestriction)
 
-	branchRestriction, err := r.client.CreateBranchRestriction(plan.RepositorySlug.ValueString(), newBranchRestriction)
+	branchRestrictions, err := r.client.WithWorkspace(plan.Workspace.ValueString()).GetBranchRestrictions(plan.RepositorySlug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating branch restriction",
+			"Could not read existing branch restrictions, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var branchRestriction *client.BranchRestriction
+
+	matches := findBranchRestrictions(branchRestrictions.Values, newBranchRestriction)
+	if len(matches) > 0 {
+		if !r.adoptExisting {
+			resp.Diagnostics.AddError(
+				"Error creating branch restriction",
+				fmt.Sprintf("A %s branch restriction for pattern %q already exists with id %d. "+
+					"Import it or set adopt_existing_branch_restrictions to true in the provider configuration.", newBranchRestriction.Kind, newBranchRestriction.Pattern, matches[0].ID),
+			)
+			return
+		}
+
+		tflog.Info(ctx, "Adopting existing branch restriction", map[string]any{"id": matches[0].ID})
+
+		branchRestriction, err = r.client.WithWorkspace(plan.Workspace.ValueString()).UpdateBranchRestriction(plan.RepositorySlug.ValueString(), matches[0].ID, newBranchRestriction)
+	} else {
+		branchRestriction, err = r.client.WithWorkspace(plan.Workspace.ValueString()).CreateBranchRestriction(plan.RepositorySlug.ValueString(), newBranchRestriction)
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating branch restriction",
@@ -145,7 +216,9 @@ func (r *branchRestrictionResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	branchRestriction, err := r.client.GetBranchRestriction(state.RepositorySlug.ValueString(), int(state.ID.ValueInt64()))
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	branchRestriction, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetBranchRestriction(state.RepositorySlug.ValueString(), int(state.ID.ValueInt64()))
 	if err != nil {
 		var statusErr client.StatusErr
 		if errors.As(err, &statusErr) {
@@ -179,10 +252,19 @@ func (r *branchRestrictionResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	err := plan.resolveUsers(r.client.WithWorkspace(plan.Workspace.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Bitbucket Branch Restriction",
+			"Could not resolve branch restriction users, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
 	var newBranchRestriction client.BranchRestriction
 	plan.mapTo(&newBranchRestriction)
 
-	branchRestriction, err := r.client.UpdateBranchRestriction(plan.RepositorySlug.ValueString(), int(plan.ID.ValueInt64()), newBranchRestriction)
+	branchRestriction, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdateBranchRestriction(plan.RepositorySlug.ValueString(), int(plan.ID.ValueInt64()), newBranchRestriction)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Bitbucket Branch Restriction",
@@ -208,7 +290,7 @@ func (r *branchRestrictionResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	err := r.client.DeleteBranchRestriction(state.RepositorySlug.ValueString(), int(state.ID.ValueInt64()))
+	err := r.client.WithWorkspace(state.Workspace.ValueString()).DeleteBranchRestriction(state.RepositorySlug.ValueString(), int(state.ID.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Bitbucket Branch Restriction",
@@ -223,41 +305,153 @@ func (r *branchRestrictionResource) Configure(_ context.Context, req resource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*bitbucketProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.adoptExisting = data.adoptExistingBranchRestrictions
 }
 
 func (r *branchRestrictionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	workspace, importID := splitImportWorkspace(req.ID)
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+
+	repositorySlug, idValue, match, ok := parseBranchRestrictionImportID(importID)
+	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: repository_slug,id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [workspace/]repository_slug,id or [workspace/]repository_slug:kind:pattern or [workspace/]repository_slug:kind:branching_model:branch_type. Got: %q", req.ID),
 		)
 		return
 	}
 
-	id, err := strconv.Atoi(idParts[1])
+	if idValue != "" {
+		id, err := strconv.Atoi(idValue)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error converting ID",
+				"Could not convert resource id, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), repositorySlug)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
+	branchRestrictions, err := r.client.WithWorkspace(workspace).GetBranchRestrictions(repositorySlug)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error converting ID",
-			"Could not convert resource id, unexpected error: "+err.Error(),
+			"Error Importing Bitbucket Branch Restriction",
+			"Could not read branch restrictions for repository "+repositorySlug+": "+err.Error(),
 		)
+		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	matches := findBranchRestrictions(branchRestrictions.Values, match)
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Error Importing Bitbucket Branch Restriction",
+			fmt.Sprintf("No branch restriction matches %q in repository %s.", req.ID, repositorySlug),
+		)
+		return
+	}
+
+	if len(matches) > 1 {
+		var ids []string
+		for _, branchRestriction := range matches {
+			ids = append(ids, strconv.Itoa(branchRestriction.ID))
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Importing Bitbucket Branch Restriction",
+			fmt.Sprintf("Multiple branch restrictions match %q in repository %s (ids: %s). "+
+				"Import one of them with the format repository_slug,id.", req.ID, repositorySlug, strings.Join(ids, ", ")),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), repositorySlug)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), matches[0].ID)...)
+}
+
+// parseBranchRestrictionImportID splits an import identifier without its
+// workspace into the repository slug and either the restriction id or the
+// kind and pattern or branch type to match. The repository slug is followed by
+// a "," and the id or a ":" and the kind, and only the first separator decides
+// the format, so patterns such as "release/*,hotfix/*" are kept intact.
+func parseBranchRestrictionImportID(importID string) (string, string, client.BranchRestriction, bool) {
+	var match client.BranchRestriction
+
+	if i := strings.IndexAny(importID, ",:"); i >= 0 && importID[i] == ',' {
+		idParts := strings.SplitN(importID, ",", 2)
+		return idParts[0], idParts[1], match, idParts[0] != "" && idParts[1] != ""
+	}
+
+	idParts := strings.SplitN(importID, ":", 3)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", match, false
+	}
+
+	match.Kind = idParts[1]
+	match.BranchMatchKind = "glob"
+	match.Pattern = idParts[2]
+
+	if branchType, ok := strings.CutPrefix(idParts[2], "branching_model:"); ok {
+		match.BranchMatchKind = "branching_model"
+		match.Pattern = ""
+		match.BranchType = &branchType
+	}
+
+	if match.Pattern == "" && (match.BranchType == nil || *match.BranchType == "") {
+		return "", "", match, false
+	}
+
+	return idParts[0], "", match, true
+}
+
+func findBranchRestrictions(branchRestrictions []client.BranchRestriction, match client.BranchRestriction) []client.BranchRestriction {
+	var matches []client.BranchRestriction
+	for _, branchRestriction := range branchRestrictions {
+		if branchRestriction.Kind == match.Kind &&
+			branchRestriction.BranchMatchKind == match.BranchMatchKind &&
+			types.StringPointerValue(branchRestriction.BranchType).ValueString() == types.StringPointerValue(match.BranchType).ValueString() &&
+			branchRestriction.Pattern == match.Pattern {
+			matches = append(matches, branchRestriction)
+		}
+	}
+
+	return matches
+}
+
+func (m *branchRestrictionResourceModel) resolveUsers(c *client.Client) error {
+	for i, user := range m.Users {
+		if isKnown(user.Uuid) {
+			continue
+		}
+
+		resolved, err := resolveUser(c, user)
+		if err != nil {
+			return err
+		}
+
+		m.Users[i].Uuid = types.StringValue(resolved.Uuid)
+	}
+
+	return nil
 }
 
 func (m *branchRestrictionResourceModel) mapTo(c *client.BranchRestriction) {
@@ -277,6 +471,7 @@ func (m *branchRestrictionResourceModel) mapTo(c *client.BranchRestriction) {
 
 	c.Kind = m.Kind.ValueString()
 	c.BranchMatchKind = m.BranchMatchKind.ValueString()
+	c.BranchType = m.BranchType.ValueStringPointer()
 	c.Pattern = m.Pattern.ValueString()
 	c.Users = users
 	c.Groups = groups
@@ -286,12 +481,26 @@ func (m *branchRestrictionResourceModel) mapFrom(c *client.BranchRestriction) {
 	m.ID = types.Int64Value(int64(c.ID))
 	m.Kind = types.StringValue(c.Kind)
 	m.BranchMatchKind = types.StringValue(c.BranchMatchKind)
+	m.BranchType = types.StringPointerValue(c.BranchType)
 	m.Pattern = types.StringValue(c.Pattern)
 
+	emails := map[string]types.String{}
+	for _, user := range m.Users {
+		emails[user.Uuid.ValueString()] = user.Email
+	}
+
 	m.Users = []userModel{}
 	for _, user := range c.Users {
+		email, ok := emails[user.Uuid]
+		if !ok {
+			email = types.StringNull()
+		}
+
 		m.Users = append(m.Users, userModel{
-			Uuid: types.StringValue(user.Uuid),
+			Uuid:      types.StringValue(user.Uuid),
+			AccountID: stringValueOrNull(user.AccountID),
+			Nickname:  stringValueOrNull(user.Nickname),
+			Email:     email,
 		})
 	}
 
@@ -302,3 +511,90 @@ func (m *branchRestrictionResourceModel) mapFrom(c *client.BranchRestriction) {
 		})
 	}
 }
+
+var _ planmodifier.List = usersPlanModifier{}
+
+type usersPlanModifier struct{}
+
+// usersUseStateForUnknown copies resolved user identifiers from the prior state.
+func usersUseStateForUnknown() planmodifier.List {
+	return usersPlanModifier{}
+}
+
+func (m usersPlanModifier) Description(_ context.Context) string {
+	return "Copies user identifiers resolved in the prior state to the plan."
+}
+
+func (m usersPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m usersPlanModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var state, plan []userModel
+	resp.Diagnostics.Append(req.StateValue.ElementsAs(ctx, &state, false)...)
+	resp.Diagnostics.Append(req.PlanValue.ElementsAs(ctx, &plan, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, user := range plan {
+		for _, prior := range state {
+			if !user.refersTo(prior) {
+				continue
+			}
+
+			if user.Uuid.IsUnknown() {
+				plan[i].Uuid = prior.Uuid
+			}
+			if user.AccountID.IsUnknown() {
+				plan[i].AccountID = prior.AccountID
+			}
+			if user.Nickname.IsUnknown() {
+				plan[i].Nickname = prior.Nickname
+			}
+			break
+		}
+	}
+
+	planValue, diags := types.ListValueFrom(ctx, req.PlanValue.ElementType(ctx), plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.PlanValue = planValue
+}
+
+func (m userModel) refersTo(prior userModel) bool {
+	identifiers := 0
+	for _, pair := range [][2]types.String{
+		{m.Uuid, prior.Uuid},
+		{m.AccountID, prior.AccountID},
+		{m.Nickname, prior.Nickname},
+		{m.Email, prior.Email},
+	} {
+		if !isKnown(pair[0]) {
+			continue
+		}
+
+		if !pair[0].Equal(pair[1]) {
+			return false
+		}
+
+		identifiers++
+	}
+
+	return identifiers > 0
+}
+
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
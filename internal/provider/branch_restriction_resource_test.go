@@ -0,0 +1,53 @@
+package provider
+
+import "testing"
+
+func TestParseBranchRestrictionImportID(t *testing.T) {
+	tests := []struct {
+		importID        string
+		repositorySlug  string
+		id              string
+		kind            string
+		branchMatchKind string
+		pattern         string
+		branchType      string
+		ok              bool
+	}{
+		{importID: "repo,42", repositorySlug: "repo", id: "42", ok: true},
+		{importID: "repo:push:main", repositorySlug: "repo", kind: "push", branchMatchKind: "glob", pattern: "main", ok: true},
+		{importID: "repo:push:release/*,hotfix/*", repositorySlug: "repo", kind: "push", branchMatchKind: "glob", pattern: "release/*,hotfix/*", ok: true},
+		{importID: "repo:push:team:*", repositorySlug: "repo", kind: "push", branchMatchKind: "glob", pattern: "team:*", ok: true},
+		{importID: "repo:push:branching_model:release", repositorySlug: "repo", kind: "push", branchMatchKind: "branching_model", branchType: "release", ok: true},
+		{importID: "repo"},
+		{importID: "repo,"},
+		{importID: ",42"},
+		{importID: "repo:push"},
+		{importID: "repo:push:"},
+		{importID: ":push:main"},
+		{importID: "repo::main"},
+		{importID: "repo:push:branching_model:"},
+	}
+
+	for _, test := range tests {
+		repositorySlug, id, match, ok := parseBranchRestrictionImportID(test.importID)
+		if ok != test.ok {
+			t.Errorf("parseBranchRestrictionImportID(%q) ok = %t, want %t", test.importID, ok, test.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		branchType := ""
+		if match.BranchType != nil {
+			branchType = *match.BranchType
+		}
+
+		if repositorySlug != test.repositorySlug || id != test.id || match.Kind != test.kind ||
+			match.BranchMatchKind != test.branchMatchKind || match.Pattern != test.pattern || branchType != test.branchType {
+			t.Errorf("parseBranchRestrictionImportID(%q) = %q, %q, {%q %q %q %q}, want %q, %q, {%q %q %q %q}",
+				test.importID, repositorySlug, id, match.Kind, match.BranchMatchKind, match.Pattern, branchType,
+				test.repositorySlug, test.id, test.kind, test.branchMatchKind, test.pattern, test.branchType)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &branchRestrictionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &branchRestrictionsDataSource{}
+)
+
+type branchRestrictionModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Kind            types.String `tfsdk:"kind"`
+	BranchMatchKind types.String `tfsdk:"branch_match_kind"`
+	BranchType      types.String `tfsdk:"branch_type"`
+	Pattern         types.String `tfsdk:"pattern"`
+	Value           types.Int64  `tfsdk:"value"`
+	Users           []userModel  `tfsdk:"users"`
+	Groups          []groupModel `tfsdk:"groups"`
+}
+
+type branchRestrictionsDataSourceModel struct {
+	Workspace      types.String             `tfsdk:"workspace"`
+	RepositorySlug types.String             `tfsdk:"repository_slug"`
+	Kind           types.String             `tfsdk:"kind"`
+	Pattern        types.String             `tfsdk:"pattern"`
+	Restrictions   []branchRestrictionModel `tfsdk:"restrictions"`
+}
+
+func NewBranchRestrictionsDataSource() datasource.DataSource {
+	return &branchRestrictionsDataSource{}
+}
+
+type branchRestrictionsDataSource struct {
+	client *client.Client
+}
+
+func (d *branchRestrictionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch_restrictions"
+}
+
+func (d *branchRestrictionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+			},
+			"repository_slug": schema.StringAttribute{
+				Required: true,
+			},
+			"kind": schema.StringAttribute{
+				Optional: true,
+			},
+			"pattern": schema.StringAttribute{
+				Optional: true,
+			},
+			"restrictions": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"kind": schema.StringAttribute{
+							Computed: true,
+						},
+						"branch_match_kind": schema.StringAttribute{
+							Computed: true,
+						},
+						"branch_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"pattern": schema.StringAttribute{
+							Computed: true,
+						},
+						"value": schema.Int64Attribute{
+							Computed: true,
+						},
+						"users": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"uuid": schema.StringAttribute{
+										Computed: true,
+									},
+									"account_id": schema.StringAttribute{
+										Computed: true,
+									},
+									"nickname": schema.StringAttribute{
+										Computed: true,
+									},
+									"email": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+						"groups": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"slug": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *branchRestrictionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state branchRestrictionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	branchRestrictions, err := d.client.WithWorkspace(state.Workspace.ValueString()).GetBranchRestrictions(state.RepositorySlug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Bitbucket Branch Restrictions",
+			"Could not read Bitbucket branch restrictions for repository "+state.RepositorySlug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.mapFrom(branchRestrictions.Values)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *branchRestrictionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+}
+
+func (m *branchRestrictionsDataSourceModel) mapFrom(c []client.BranchRestriction) {
+	m.Restrictions = []branchRestrictionModel{}
+	for _, branchRestriction := range c {
+		if !m.Kind.IsNull() && branchRestriction.Kind != m.Kind.ValueString() {
+			continue
+		}
+
+		if !m.Pattern.IsNull() && branchRestriction.Pattern != m.Pattern.ValueString() {
+			continue
+		}
+
+		var restriction branchRestrictionModel
+		restriction.mapFrom(&branchRestriction)
+		m.Restrictions = append(m.Restrictions, restriction)
+	}
+}
+
+func (m *branchRestrictionModel) mapFrom(c *client.BranchRestriction) {
+	m.ID = types.Int64Value(int64(c.ID))
+	m.Kind = types.StringValue(c.Kind)
+	m.BranchMatchKind = types.StringValue(c.BranchMatchKind)
+	m.BranchType = types.StringPointerValue(c.BranchType)
+	m.Pattern = types.StringValue(c.Pattern)
+	m.Value = types.Int64PointerValue(c.Value)
+
+	m.Users = []userModel{}
+	for _, user := range c.Users {
+		m.Users = append(m.Users, userModel{
+			Uuid:      types.StringValue(user.Uuid),
+			AccountID: types.StringValue(user.AccountID),
+			Nickname:  types.StringValue(user.Nickname),
+			Email:     types.StringNull(),
+		})
+	}
+
+	m.Groups = []groupModel{}
+	for _, group := range c.Groups {
+		m.Groups = append(m.Groups, groupModel{
+			Slug: types.StringValue(group.Slug),
+		})
+	}
+}
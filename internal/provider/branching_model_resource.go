@@ -0,0 +1,439 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &branchingModelResource{}
+	_ resource.ResourceWithConfigure      = &branchingModelResource{}
+	_ resource.ResourceWithImportState    = &branchingModelResource{}
+	_ resource.ResourceWithModifyPlan     = &branchingModelResource{}
+	_ resource.ResourceWithValidateConfig = &branchingModelResource{}
+)
+
+var branchTypeKinds = []string{"feature", "bugfix", "release", "hotfix"}
+
+type branchingModelBranchModel struct {
+	Name          types.String `tfsdk:"name"`
+	UseMainbranch types.Bool   `tfsdk:"use_mainbranch"`
+}
+
+type branchTypeModel struct {
+	Kind   types.String `tfsdk:"kind"`
+	Prefix types.String `tfsdk:"prefix"`
+}
+
+type branchingModelResourceModel struct {
+	Workspace      types.String               `tfsdk:"workspace"`
+	RepositorySlug types.String               `tfsdk:"repository_slug"`
+	Development    *branchingModelBranchModel `tfsdk:"development"`
+	Production     *branchingModelBranchModel `tfsdk:"production"`
+	BranchTypes    []branchTypeModel          `tfsdk:"branch_types"`
+}
+
+func NewBranchingModelResource() resource.Resource {
+	return &branchingModelResource{}
+}
+
+type branchingModelResource struct {
+	client *client.Client
+}
+
+func (r *branchingModelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branching_model"
+}
+
+func (r *branchingModelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := branchingModelSchemaAttributes()
+	attributes["repository_slug"] = schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (r *branchingModelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
+func (r *branchingModelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateBranchingModelBranch(ctx, req.Config, "development")...)
+	resp.Diagnostics.Append(validateBranchingModelBranch(ctx, req.Config, "production")...)
+}
+
+func (r *branchingModelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan branchingModelResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	newSettings := branchingModelSettingsFrom(plan.Development, plan.Production, plan.BranchTypes)
+
+	settings, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdateBranchingModelSettings(plan.RepositorySlug.ValueString(), newSettings)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating branching model",
+			"Could not update branching model settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Development, plan.Production, plan.BranchTypes = branchingModelFrom(settings, plan.BranchTypes)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *branchingModelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state branchingModelResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	settings, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetBranchingModelSettings(state.RepositorySlug.ValueString())
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) {
+			if statusErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Bitbucket Branching Model",
+			"Could not read Bitbucket branching model for repository "+state.RepositorySlug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Development, state.Production, state.BranchTypes = branchingModelFrom(settings, state.BranchTypes)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *branchingModelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan branchingModelResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newSettings := branchingModelSettingsFrom(plan.Development, plan.Production, plan.BranchTypes)
+
+	settings, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdateBranchingModelSettings(plan.RepositorySlug.ValueString(), newSettings)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Bitbucket Branching Model",
+			"Could not update branching model settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Development, plan.Production, plan.BranchTypes = branchingModelFrom(settings, plan.BranchTypes)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *branchingModelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state branchingModelResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.WithWorkspace(state.Workspace.ValueString()).UpdateBranchingModelSettings(state.RepositorySlug.ValueString(), defaultBranchingModelSettings())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Bitbucket Branching Model",
+			"Could not reset branching model settings to defaults, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *branchingModelResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *branchingModelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, repositorySlug := splitImportWorkspace(req.ID)
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), repositorySlug)...)
+}
+
+func branchingModelSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"workspace": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"development": schema.SingleNestedAttribute{
+			Required: true,
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						branchNameValidator{},
+					},
+				},
+				"use_mainbranch": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(false),
+				},
+			},
+		},
+		"production": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						branchNameValidator{},
+					},
+				},
+				"use_mainbranch": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(false),
+				},
+			},
+		},
+		"branch_types": schema.SetNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"kind": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringOneOf(branchTypeKinds...),
+						},
+					},
+					"prefix": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// branchingModelSettingsFrom builds the settings to send to Bitbucket. A null
+// production branch disables it, and branch types that are not configured are
+// sent as disabled so that the configuration owns the whole settings object.
+func branchingModelSettingsFrom(development, production *branchingModelBranchModel, branchTypes []branchTypeModel) client.BranchingModelSettings {
+	var settings client.BranchingModelSettings
+
+	settings.Development.Name = development.Name.ValueStringPointer()
+	settings.Development.UseMainbranch = development.UseMainbranch.ValueBool()
+
+	productionEnabled := production != nil
+	settings.Production.Enabled = &productionEnabled
+	if production != nil {
+		settings.Production.Name = production.Name.ValueStringPointer()
+		settings.Production.UseMainbranch = production.UseMainbranch.ValueBool()
+	}
+
+	prefixes := map[string]string{}
+	for _, branchType := range branchTypes {
+		prefixes[branchType.Kind.ValueString()] = branchType.Prefix.ValueString()
+	}
+
+	for _, kind := range branchTypeKinds {
+		prefix, enabled := prefixes[kind]
+		settings.BranchTypes = append(settings.BranchTypes, client.BranchType{
+			Kind:    kind,
+			Prefix:  prefix,
+			Enabled: enabled,
+		})
+	}
+
+	return settings
+}
+
+// branchingModelFrom maps the settings back to their attributes. Branch types
+// that were configured as an empty set stay empty instead of becoming null.
+func branchingModelFrom(c *client.BranchingModelSettings, priorBranchTypes []branchTypeModel) (*branchingModelBranchModel, *branchingModelBranchModel, []branchTypeModel) {
+	development := &branchingModelBranchModel{
+		Name:          types.StringNull(),
+		UseMainbranch: types.BoolValue(c.Development.UseMainbranch),
+	}
+	if !c.Development.UseMainbranch {
+		development.Name = types.StringPointerValue(c.Development.Name)
+	}
+
+	var production *branchingModelBranchModel
+	if c.Production.Enabled != nil && *c.Production.Enabled {
+		production = &branchingModelBranchModel{
+			Name:          types.StringNull(),
+			UseMainbranch: types.BoolValue(c.Production.UseMainbranch),
+		}
+		if !c.Production.UseMainbranch {
+			production.Name = types.StringPointerValue(c.Production.Name)
+		}
+	}
+
+	var branchTypes []branchTypeModel
+	if priorBranchTypes != nil {
+		branchTypes = []branchTypeModel{}
+	}
+	for _, branchType := range c.BranchTypes {
+		if !branchType.Enabled {
+			continue
+		}
+
+		branchTypes = append(branchTypes, branchTypeModel{
+			Kind:   types.StringValue(branchType.Kind),
+			Prefix: types.StringValue(branchType.Prefix),
+		})
+	}
+
+	return development, production, branchTypes
+}
+
+func defaultBranchingModelSettings() client.BranchingModelSettings {
+	productionEnabled := false
+
+	settings := client.BranchingModelSettings{
+		Development: client.BranchingModelBranch{
+			UseMainbranch: true,
+		},
+		Production: client.BranchingModelBranch{
+			Enabled: &productionEnabled,
+		},
+	}
+
+	for _, kind := range branchTypeKinds {
+		settings.BranchTypes = append(settings.BranchTypes, client.BranchType{
+			Kind:    kind,
+			Prefix:  kind + "/",
+			Enabled: true,
+		})
+	}
+
+	return settings
+}
+
+// validateBranchingModelBranch requires a configured branch to either name a
+// branch or use the main branch, since Bitbucket rejects a branch with neither.
+// Setting both is rejected by branchNameValidator.
+func validateBranchingModelBranch(ctx context.Context, config tfsdk.Config, attribute string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var branch types.Object
+	diags.Append(config.GetAttribute(ctx, path.Root(attribute), &branch)...)
+	if diags.HasError() || branch.IsNull() || branch.IsUnknown() {
+		return diags
+	}
+
+	var name types.String
+	var useMainbranch types.Bool
+	diags.Append(config.GetAttribute(ctx, path.Root(attribute).AtName("name"), &name)...)
+	diags.Append(config.GetAttribute(ctx, path.Root(attribute).AtName("use_mainbranch"), &useMainbranch)...)
+	if diags.HasError() || name.IsUnknown() || useMainbranch.IsUnknown() {
+		return diags
+	}
+
+	if name.IsNull() && !useMainbranch.ValueBool() {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Missing Branch Name",
+			fmt.Sprintf("%s requires either a name or use_mainbranch set to true.", attribute),
+		)
+	}
+
+	return diags
+}
+
+var _ validator.String = branchNameValidator{}
+
+type branchNameValidator struct{}
+
+func (v branchNameValidator) Description(_ context.Context) string {
+	return "name must not be set when use_mainbranch is true"
+}
+
+func (v branchNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v branchNameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+
+	var useMainbranch types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("use_mainbranch"), &useMainbranch)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if useMainbranch.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Conflicting Branch Name",
+			"A branch name cannot be set when use_mainbranch is true, Bitbucket uses the repository main branch instead.",
+		)
+	}
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &currentUserDataSource{}
+	_ datasource.DataSourceWithConfigure = &currentUserDataSource{}
+)
+
+type currentUserWorkspaceModel struct {
+	Uuid       types.String `tfsdk:"uuid"`
+	Slug       types.String `tfsdk:"slug"`
+	Name       types.String `tfsdk:"name"`
+	Permission types.String `tfsdk:"permission"`
+}
+
+type currentUserDataSourceModel struct {
+	Uuid        types.String                `tfsdk:"uuid"`
+	AccountID   types.String                `tfsdk:"account_id"`
+	Nickname    types.String                `tfsdk:"nickname"`
+	DisplayName types.String                `tfsdk:"display_name"`
+	Workspaces  []currentUserWorkspaceModel `tfsdk:"workspaces"`
+}
+
+func NewCurrentUserDataSource() datasource.DataSource {
+	return &currentUserDataSource{}
+}
+
+type currentUserDataSource struct {
+	client *client.Client
+}
+
+func (d *currentUserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_user"
+}
+
+func (d *currentUserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed: true,
+			},
+			"account_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"nickname": schema.StringAttribute{
+				Computed: true,
+			},
+			"display_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"workspaces": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Computed: true,
+						},
+						"slug": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"permission": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *currentUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state currentUserDataSourceModel
+
+	user, err := d.client.GetCurrentUser()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Bitbucket Current User",
+			"Could not read the Bitbucket user behind the configured token: "+err.Error(),
+		)
+		return
+	}
+
+	workspacePermissions, err := d.client.GetCurrentUserWorkspaces()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Bitbucket Current User",
+			"Could not read the workspaces of the Bitbucket user behind the configured token: "+err.Error(),
+		)
+		return
+	}
+
+	state.mapFrom(user, workspacePermissions.Values)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *currentUserDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+}
+
+func (m *currentUserDataSourceModel) mapFrom(c *client.User, workspacePermissions []client.WorkspacePermission) {
+	m.Uuid = types.StringValue(c.Uuid)
+	m.AccountID = types.StringValue(c.AccountID)
+	m.Nickname = types.StringValue(c.Nickname)
+	m.DisplayName = types.StringValue(c.DisplayName)
+
+	m.Workspaces = []currentUserWorkspaceModel{}
+	for _, workspacePermission := range workspacePermissions {
+		m.Workspaces = append(m.Workspaces, currentUserWorkspaceModel{
+			Uuid:       types.StringValue(workspacePermission.Workspace.Uuid),
+			Slug:       types.StringValue(workspacePermission.Workspace.Slug),
+			Name:       types.StringValue(workspacePermission.Workspace.Name),
+			Permission: types.StringValue(workspacePermission.Permission),
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &defaultReviewerResource{}
+	_ resource.ResourceWithConfigure   = &defaultReviewerResource{}
+	_ resource.ResourceWithImportState = &defaultReviewerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultReviewerResource{}
+)
+
+type defaultReviewerResourceModel struct {
+	Workspace      types.String `tfsdk:"workspace"`
+	RepositorySlug types.String `tfsdk:"repository_slug"`
+	User           types.String `tfsdk:"user"`
+}
+
+func NewDefaultReviewerResource() resource.Resource {
+	return &defaultReviewerResource{}
+}
+
+type defaultReviewerResource struct {
+	client *client.Client
+}
+
+func (r *defaultReviewerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_reviewer"
+}
+
+func (r *defaultReviewerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: defaultReviewerSchemaAttributes("repository_slug"),
+	}
+}
+
+func (r *defaultReviewerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
+func (r *defaultReviewerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan defaultReviewerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	defaultReviewer, diags := createDefaultReviewer("default reviewer", func() (*client.User, error) {
+		return r.client.WithWorkspace(plan.Workspace.ValueString()).CreateDefaultReviewer(plan.RepositorySlug.ValueString(), plan.User.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.mapFrom(defaultReviewer)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *defaultReviewerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state defaultReviewerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	defaultReviewer, diags := readDefaultReviewer("Default Reviewer", "repository "+state.RepositorySlug.ValueString(), func() (*client.User, error) {
+		return r.client.WithWorkspace(state.Workspace.ValueString()).GetDefaultReviewer(state.RepositorySlug.ValueString(), state.User.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if defaultReviewer == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.mapFrom(defaultReviewer)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *defaultReviewerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan defaultReviewerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *defaultReviewerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state defaultReviewerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = deleteDefaultReviewer("Default Reviewer", func() error {
+		return r.client.WithWorkspace(state.Workspace.ValueString()).DeleteDefaultReviewer(state.RepositorySlug.ValueString(), state.User.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *defaultReviewerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *defaultReviewerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, importID := splitImportWorkspace(req.ID)
+	idParts := strings.Split(importID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: [workspace/]repository_slug,user. Got: %q", req.ID),
+		)
+		return
+	}
+
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), idParts[1])...)
+}
+
+func (m *defaultReviewerResourceModel) mapFrom(c *client.User) {
+	m.User = defaultReviewerUser(m.User, c)
+}
+
+// defaultReviewerSchemaAttributes returns the attributes shared by the
+// repository and project default reviewers, which only differ in the attribute
+// that scopes them.
+func defaultReviewerSchemaAttributes(scopeAttribute string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"workspace": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		scopeAttribute: schema.StringAttribute{
+			Required: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"user": schema.StringAttribute{
+			Required: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+// defaultReviewerUser keeps the configured user when it refers to the reviewer
+// Bitbucket returned, so that an account id or a differently written uuid does
+// not show up as drift.
+func defaultReviewerUser(user types.String, c *client.User) types.String {
+	if !user.IsNull() && !user.IsUnknown() && sameUser(user.ValueString(), c) {
+		return user
+	}
+
+	return types.StringValue(c.Uuid)
+}
+
+func sameUser(user string, c *client.User) bool {
+	if c.AccountID != "" && user == c.AccountID {
+		return true
+	}
+
+	return normalizeUuid(user) == normalizeUuid(c.Uuid)
+}
+
+func normalizeUuid(uuid string) string {
+	return strings.ToLower(strings.Trim(uuid, "{}"))
+}
+
+func createDefaultReviewer(name string, create func() (*client.User, error)) (*client.User, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	defaultReviewer, err := create()
+	if err != nil {
+		diags.AddError(
+			"Error creating "+name,
+			"Could not create "+name+", unexpected error: "+err.Error(),
+		)
+	}
+
+	return defaultReviewer, diags
+}
+
+// readDefaultReviewer returns a nil user without diagnostics when the
+// reviewer no longer exists.
+func readDefaultReviewer(title, scope string, get func() (*client.User, error)) (*client.User, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	defaultReviewer, err := get()
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) {
+			if statusErr.StatusCode == 404 {
+				return nil, diags
+			}
+		}
+
+		diags.AddError(
+			"Error Reading Bitbucket "+title,
+			"Could not read Bitbucket default reviewer for "+scope+": "+err.Error(),
+		)
+	}
+
+	return defaultReviewer, diags
+}
+
+func deleteDefaultReviewer(title string, remove func() error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := remove()
+	if err != nil {
+		diags.AddError(
+			"Error Deleting Bitbucket "+title,
+			"Could not delete "+strings.ToLower(title)+", unexpected error: "+err.Error(),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &defaultReviewersResource{}
+	_ resource.ResourceWithConfigure   = &defaultReviewersResource{}
+	_ resource.ResourceWithImportState = &defaultReviewersResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultReviewersResource{}
+)
+
+type defaultReviewersResourceModel struct {
+	Workspace      types.String   `tfsdk:"workspace"`
+	RepositorySlug types.String   `tfsdk:"repository_slug"`
+	Users          []types.String `tfsdk:"users"`
+}
+
+func NewDefaultReviewersResource() resource.Resource {
+	return &defaultReviewersResource{}
+}
+
+type defaultReviewersResource struct {
+	client *client.Client
+}
+
+func (r *defaultReviewersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_reviewers"
+}
+
+func (r *defaultReviewersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"repository_slug": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *defaultReviewersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
+func (r *defaultReviewersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan defaultReviewersResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	resp.Diagnostics.Append(r.apply(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *defaultReviewersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state defaultReviewersResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	defaultReviewers, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetDefaultReviewers(state.RepositorySlug.ValueString())
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) {
+			if statusErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Bitbucket Default Reviewers",
+			"Could not read Bitbucket default reviewers for repository "+state.RepositorySlug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.mapFrom(defaultReviewers.Values)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *defaultReviewersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan defaultReviewersResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *defaultReviewersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state defaultReviewersResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := r.client.WithWorkspace(state.Workspace.ValueString())
+	for _, user := range state.Users {
+		err := c.DeleteDefaultReviewer(state.RepositorySlug.ValueString(), user.ValueString())
+		if err != nil {
+			var statusErr client.StatusErr
+			if errors.As(err, &statusErr) && statusErr.StatusCode == 404 {
+				continue
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Deleting Bitbucket Default Reviewers",
+				"Could not delete default reviewer, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *defaultReviewersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *defaultReviewersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, repositorySlug := splitImportWorkspace(req.ID)
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), repositorySlug)...)
+}
+
+func (r *defaultReviewersResource) apply(plan *defaultReviewersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := r.client.WithWorkspace(plan.Workspace.ValueString())
+	repositorySlug := plan.RepositorySlug.ValueString()
+
+	defaultReviewers, err := c.GetDefaultReviewers(repositorySlug)
+	if err != nil {
+		diags.AddError(
+			"Error Applying Bitbucket Default Reviewers",
+			"Could not read default reviewers, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	existing := map[string]bool{}
+	for _, defaultReviewer := range defaultReviewers.Values {
+		existing[defaultReviewer.Uuid] = true
+	}
+
+	desired := map[string]bool{}
+	for _, user := range plan.Users {
+		desired[user.ValueString()] = true
+
+		if existing[user.ValueString()] {
+			continue
+		}
+
+		_, err := c.CreateDefaultReviewer(repositorySlug, user.ValueString())
+		if err != nil {
+			diags.AddError(
+				"Error Applying Bitbucket Default Reviewers",
+				"Could not add default reviewer "+user.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	for _, defaultReviewer := range defaultReviewers.Values {
+		if desired[defaultReviewer.Uuid] {
+			continue
+		}
+
+		err := c.DeleteDefaultReviewer(repositorySlug, defaultReviewer.Uuid)
+		if err != nil {
+			diags.AddError(
+				"Error Applying Bitbucket Default Reviewers",
+				"Could not remove default reviewer "+defaultReviewer.Uuid+", unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func (m *defaultReviewersResourceModel) mapFrom(c []client.User) {
+	m.Users = []types.String{}
+	for _, user := range c {
+		m.Users = append(m.Users, types.StringValue(user.Uuid))
+	}
+}
@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &deployKeyResource{}
+	_ resource.ResourceWithConfigure   = &deployKeyResource{}
+	_ resource.ResourceWithImportState = &deployKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &deployKeyResource{}
+)
+
+type deployKeyResourceModel struct {
+	Workspace      types.String `tfsdk:"workspace"`
+	ID             types.Int64  `tfsdk:"id"`
+	RepositorySlug types.String `tfsdk:"repository_slug"`
+	Key            types.String `tfsdk:"key"`
+	Label          types.String `tfsdk:"label"`
+}
+
+func NewDeployKeyResource() resource.Resource {
+	return &deployKeyResource{}
+}
+
+type deployKeyResource struct {
+	client *client.Client
+}
+
+func (r *deployKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deploy_key"
+}
+
+func (r *deployKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository_slug": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					sshKeyRequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *deployKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
+func (r *deployKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan deployKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	var newDeployKey client.DeployKey
+	plan.mapTo(&newDeployKey)
+
+	deployKey, err := r.client.WithWorkspace(plan.Workspace.ValueString()).CreateDeployKey(plan.RepositorySlug.ValueString(), newDeployKey)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating deploy key",
+			"Could not create deploy key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(deployKey)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *deployKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state deployKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	deployKey, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetDeployKey(state.RepositorySlug.ValueString(), int(state.ID.ValueInt64()))
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) {
+			if statusErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Bitbucket Deploy Key",
+			"Could not read Bitbucket deploy key id "+state.ID.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.mapFrom(deployKey)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *deployKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan deployKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var newDeployKey client.DeployKey
+	plan.mapTo(&newDeployKey)
+
+	deployKey, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdateDeployKey(plan.RepositorySlug.ValueString(), int(plan.ID.ValueInt64()), newDeployKey)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Bitbucket Deploy Key",
+			"Could not update deploy key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(deployKey)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *deployKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state deployKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.WithWorkspace(state.Workspace.ValueString()).DeleteDeployKey(state.RepositorySlug.ValueString(), int(state.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Bitbucket Deploy Key",
+			"Could not delete deploy key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *deployKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *deployKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, importID := splitImportWorkspace(req.ID)
+	idParts := strings.Split(importID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: [workspace/]repository_slug,id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	id, err := strconv.Atoi(idParts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting ID",
+			"Could not convert resource id, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (m *deployKeyResourceModel) mapTo(c *client.DeployKey) {
+	c.Key = m.Key.ValueString()
+	c.Label = m.Label.ValueString()
+}
+
+func (m *deployKeyResourceModel) mapFrom(c *client.DeployKey) {
+	m.ID = types.Int64Value(int64(c.ID))
+	m.Label = types.StringValue(c.Label)
+
+	if normalizeSSHKey(m.Key.ValueString()) != normalizeSSHKey(c.Key) {
+		m.Key = types.StringValue(c.Key)
+	}
+}
+
+// normalizeSSHKey strips the trailing comment from an OpenSSH public key,
+// leaving only the key type and body that identify it.
+func normalizeSSHKey(key string) string {
+	fields := strings.Fields(key)
+	if len(fields) > 2 {
+		fields = fields[:2]
+	}
+
+	return strings.Join(fields, " ")
+}
+
+func sshKeyRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = normalizeSSHKey(req.PlanValue.ValueString()) != normalizeSSHKey(req.StateValue.ValueString())
+		},
+		"Requires replacement when the key type or body changes.",
+		"Requires replacement when the key type or body changes.",
+	)
+}
@@ -0,0 +1,22 @@
+package provider
+
+import "testing"
+
+func TestNormalizeSSHKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{key: "ssh-ed25519 AAAAC3Nz", want: "ssh-ed25519 AAAAC3Nz"},
+		{key: "ssh-ed25519 AAAAC3Nz user@host", want: "ssh-ed25519 AAAAC3Nz"},
+		{key: "ssh-rsa AAAAB3Nz a comment with spaces", want: "ssh-rsa AAAAB3Nz"},
+		{key: "  ssh-rsa\tAAAAB3Nz\n", want: "ssh-rsa AAAAB3Nz"},
+		{key: "", want: ""},
+	}
+
+	for _, test := range tests {
+		if got := normalizeSSHKey(test.key); got != test.want {
+			t.Errorf("normalizeSSHKey(%q) = %q, want %q", test.key, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	deploymentChangeAttempts = 10
+	deploymentChangeInterval = 2 * time.Second
+)
+
+var (
+	_ resource.Resource                = &deploymentResource{}
+	_ resource.ResourceWithConfigure   = &deploymentResource{}
+	_ resource.ResourceWithImportState = &deploymentResource{}
+	_ resource.ResourceWithModifyPlan  = &deploymentResource{}
+)
+
+type deploymentResourceModel struct {
+	Workspace      types.String `tfsdk:"workspace"`
+	Uuid           types.String `tfsdk:"uuid"`
+	RepositorySlug types.String `tfsdk:"repository_slug"`
+	Name           types.String `tfsdk:"name"`
+	Stage          types.String `tfsdk:"stage"`
+	AdminOnly      types.Bool   `tfsdk:"admin_only"`
+}
+
+func NewDeploymentResource() resource.Resource {
+	return &deploymentResource{}
+}
+
+type deploymentResource struct {
+	client *client.Client
+}
+
+func (r *deploymentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+func (r *deploymentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository_slug": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"stage": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringOneOf("Test", "Staging", "Production"),
+				},
+			},
+			"admin_only": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *deploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
+func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan deploymentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	var newDeployment client.Deployment
+	plan.mapTo(&newDeployment)
+
+	deployment, err := r.client.WithWorkspace(plan.Workspace.ValueString()).CreateDeployment(plan.RepositorySlug.ValueString(), newDeployment)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating deployment",
+			"Could not create deployment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(deployment)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *deploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state deploymentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	deployment, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetDeployment(state.RepositorySlug.ValueString(), state.Uuid.ValueString())
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) {
+			if statusErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Bitbucket Deployment",
+			"Could not read Bitbucket deployment "+state.Uuid.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.mapFrom(deployment)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *deploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan deploymentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state deploymentResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var change client.DeploymentChange
+	if !plan.Name.Equal(state.Name) {
+		change.Name = plan.Name.ValueString()
+	}
+	if !plan.AdminOnly.Equal(state.AdminOnly) {
+		change.Restrictions = &client.DeploymentRestrictions{AdminOnly: plan.AdminOnly.ValueBool()}
+	}
+
+	c := r.client.WithWorkspace(plan.Workspace.ValueString())
+
+	err := c.UpdateDeployment(plan.RepositorySlug.ValueString(), plan.Uuid.ValueString(), change)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Bitbucket Deployment",
+			"Could not update deployment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// The change is applied asynchronously, so the environment is polled until
+	// it reflects the plan.
+	var deployment *client.Deployment
+	for attempt := 0; attempt < deploymentChangeAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				resp.Diagnostics.AddError(
+					"Error Updating Bitbucket Deployment",
+					"Stopped waiting for the changes to deployment "+plan.Uuid.ValueString()+" to apply: "+ctx.Err().Error(),
+				)
+				return
+			case <-time.After(deploymentChangeInterval):
+			}
+		}
+
+		deployment, err = c.GetDeployment(plan.RepositorySlug.ValueString(), plan.Uuid.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Bitbucket Deployment",
+				"Could not read deployment after update, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		if deployment.Name == plan.Name.ValueString() && deployment.Restrictions.AdminOnly == plan.AdminOnly.ValueBool() {
+			break
+		}
+	}
+
+	if deployment.Name != plan.Name.ValueString() || deployment.Restrictions.AdminOnly != plan.AdminOnly.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Error Updating Bitbucket Deployment",
+			"Bitbucket did not apply the changes to deployment "+plan.Uuid.ValueString()+" in time.",
+		)
+		return
+	}
+
+	plan.mapFrom(deployment)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *deploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state deploymentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.WithWorkspace(state.Workspace.ValueString()).DeleteDeployment(state.RepositorySlug.ValueString(), state.Uuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Bitbucket Deployment",
+			"Could not delete deployment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *deploymentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *deploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, importID := splitImportWorkspace(req.ID)
+	idParts := strings.Split(importID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: [workspace/]repository_slug,uuid. Got: %q", req.ID),
+		)
+		return
+	}
+
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), idParts[1])...)
+}
+
+func (m *deploymentResourceModel) mapTo(c *client.Deployment) {
+	c.Name = m.Name.ValueString()
+	c.EnvironmentType.Name = m.Stage.ValueString()
+	c.Restrictions.AdminOnly = m.AdminOnly.ValueBool()
+}
+
+func (m *deploymentResourceModel) mapFrom(c *client.Deployment) {
+	m.Uuid = types.StringValue(c.Uuid)
+	m.Name = types.StringValue(c.Name)
+	m.Stage = types.StringValue(c.EnvironmentType.Name)
+	m.AdminOnly = types.BoolValue(c.Restrictions.AdminOnly)
+}
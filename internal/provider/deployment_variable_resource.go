@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &deploymentVariableResource{}
+	_ resource.ResourceWithConfigure   = &deploymentVariableResource{}
+	_ resource.ResourceWithImportState = &deploymentVariableResource{}
+	_ resource.ResourceWithModifyPlan  = &deploymentVariableResource{}
+)
+
+type deploymentVariableResourceModel struct {
+	Workspace      types.String `tfsdk:"workspace"`
+	Uuid           types.String `tfsdk:"uuid"`
+	RepositorySlug types.String `tfsdk:"repository_slug"`
+	Deployment     types.String `tfsdk:"deployment"`
+	Key            types.String `tfsdk:"key"`
+	Value          types.String `tfsdk:"value"`
+	Secured        types.Bool   `tfsdk:"secured"`
+}
+
+func NewDeploymentVariableResource() resource.Resource {
+	return &deploymentVariableResource{}
+}
+
+type deploymentVariableResource struct {
+	client *client.Client
+}
+
+func (r *deploymentVariableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_variable"
+}
+
+func (r *deploymentVariableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository_slug": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deployment": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Required: true,
+			},
+			"value": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"secured": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *deploymentVariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
+func (r *deploymentVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan deploymentVariableResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	var newPipelineVariable client.PipelineVariable
+	plan.mapTo(&newPipelineVariable)
+
+	pipelineVariable, err := r.client.WithWorkspace(plan.Workspace.ValueString()).CreateDeploymentVariable(plan.RepositorySlug.ValueString(), plan.Deployment.ValueString(), newPipelineVariable)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating deployment variable",
+			"Could not create deployment variable, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(pipelineVariable)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *deploymentVariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state deploymentVariableResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	pipelineVariables, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetDeploymentVariables(state.RepositorySlug.ValueString(), state.Deployment.ValueString())
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) {
+			if statusErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Bitbucket Deployment Variable",
+			"Could not read Bitbucket deployment variable "+state.Uuid.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	var pipelineVariable *client.PipelineVariable
+	for i := range pipelineVariables.Values {
+		if pipelineVariables.Values[i].Uuid == state.Uuid.ValueString() {
+			pipelineVariable = &pipelineVariables.Values[i]
+			break
+		}
+	}
+
+	if pipelineVariable == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.mapFrom(pipelineVariable)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *deploymentVariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan deploymentVariableResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var newPipelineVariable client.PipelineVariable
+	plan.mapTo(&newPipelineVariable)
+
+	pipelineVariable, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdateDeploymentVariable(plan.RepositorySlug.ValueString(), plan.Deployment.ValueString(), plan.Uuid.ValueString(), newPipelineVariable)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Bitbucket Deployment Variable",
+			"Could not update deployment variable, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(pipelineVariable)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *deploymentVariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state deploymentVariableResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.WithWorkspace(state.Workspace.ValueString()).DeleteDeploymentVariable(state.RepositorySlug.ValueString(), state.Deployment.ValueString(), state.Uuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Bitbucket Deployment Variable",
+			"Could not delete deployment variable, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *deploymentVariableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *deploymentVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, importID := splitImportWorkspace(req.ID)
+	idParts := strings.Split(importID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: [workspace/]repository_slug,deployment,uuid. Got: %q", req.ID),
+		)
+		return
+	}
+
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), idParts[2])...)
+}
+
+func (m *deploymentVariableResourceModel) mapTo(c *client.PipelineVariable) {
+	c.Key = m.Key.ValueString()
+	c.Value = m.Value.ValueString()
+	c.Secured = m.Secured.ValueBool()
+}
+
+func (m *deploymentVariableResourceModel) mapFrom(c *client.PipelineVariable) {
+	m.Uuid = types.StringValue(c.Uuid)
+	m.Key = types.StringValue(c.Key)
+	m.Secured = types.BoolValue(c.Secured)
+
+	if !c.Secured {
+		m.Value = types.StringValue(c.Value)
+	}
+}
@@ -19,9 +19,11 @@ var (
 	_ resource.Resource                = &groupPermissionResource{}
 	_ resource.ResourceWithConfigure   = &groupPermissionResource{}
 	_ resource.ResourceWithImportState = &groupPermissionResource{}
+	_ resource.ResourceWithModifyPlan  = &groupPermissionResource{}
 )
 
 type groupPermissionResourceModel struct {
+	Workspace      types.String `tfsdk:"workspace"`
 	RepositorySlug types.String `tfsdk:"repository_slug"`
 	GroupSlug      types.String `tfsdk:"group_slug"`
 	Permission     types.String `tfsdk:"permission"`
@@ -42,6 +44,10 @@ func (r *groupPermissionResource) Metadata(_ context.Context, req resource.Metad
 func (r *groupPermissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
 			"repository_slug": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -61,6 +67,10 @@ func (r *groupPermissionResource) Schema(_ context.Context, _ resource.SchemaReq
 	}
 }
 
+func (r *groupPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
 func (r *groupPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan groupPermissionResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -69,10 +79,12 @@ func (r *groupPermissionResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
 	var newGroupPermission client.GroupPermission
 	plan.mapTo(&newGroupPermission)
 
-	groupPermission, err := r.client.CreateGroupPermission(plan.RepositorySlug.ValueString(), plan.GroupSlug.ValueString(), newGroupPermission)
+	groupPermission, err := r.client.WithWorkspace(plan.Workspace.ValueString()).CreateGroupPermission(plan.RepositorySlug.ValueString(), plan.GroupSlug.ValueString(), newGroupPermission)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating group permission",
@@ -98,7 +110,9 @@ func (r *groupPermissionResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	groupPermission, err := r.client.GetGroupPermission(state.RepositorySlug.ValueString(), state.GroupSlug.ValueString())
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	groupPermission, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetGroupPermission(state.RepositorySlug.ValueString(), state.GroupSlug.ValueString())
 	if err != nil {
 		var statusErr client.StatusErr
 		if errors.As(err, &statusErr) {
@@ -135,7 +149,7 @@ func (r *groupPermissionResource) Update(ctx context.Context, req resource.Updat
 	var newGroupPermission client.GroupPermission
 	plan.mapTo(&newGroupPermission)
 
-	groupPermission, err := r.client.UpdateGroupPermission(plan.RepositorySlug.ValueString(), plan.GroupSlug.ValueString(), newGroupPermission)
+	groupPermission, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdateGroupPermission(plan.RepositorySlug.ValueString(), plan.GroupSlug.ValueString(), newGroupPermission)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Bitbucket Group Permission",
@@ -161,7 +175,7 @@ func (r *groupPermissionResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	err := r.client.DeleteGroupPermission(state.RepositorySlug.ValueString(), state.GroupSlug.ValueString())
+	err := r.client.WithWorkspace(state.Workspace.ValueString()).DeleteGroupPermission(state.RepositorySlug.ValueString(), state.GroupSlug.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Bitbucket Group Permission",
@@ -176,31 +190,37 @@ func (r *groupPermissionResource) Configure(_ context.Context, req resource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*bitbucketProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
 }
 
 func (r *groupPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	workspace, id := splitImportWorkspace(req.ID)
+	idParts := strings.Split(id, ",")
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: repository_slug,group_slug. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [workspace/]repository_slug,group_slug. Got: %q", req.ID),
 		)
 		return
 	}
 
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), idParts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_slug"), idParts[1])...)
 }
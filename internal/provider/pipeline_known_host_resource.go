@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &pipelineKnownHostResource{}
+	_ resource.ResourceWithConfigure   = &pipelineKnownHostResource{}
+	_ resource.ResourceWithImportState = &pipelineKnownHostResource{}
+	_ resource.ResourceWithModifyPlan  = &pipelineKnownHostResource{}
+)
+
+type pipelineKnownHostResourceModel struct {
+	Workspace      types.String `tfsdk:"workspace"`
+	Uuid           types.String `tfsdk:"uuid"`
+	RepositorySlug types.String `tfsdk:"repository_slug"`
+	Hostname       types.String `tfsdk:"hostname"`
+	PublicKey      types.String `tfsdk:"public_key"`
+}
+
+func NewPipelineKnownHostResource() resource.Resource {
+	return &pipelineKnownHostResource{}
+}
+
+type pipelineKnownHostResource struct {
+	client *client.Client
+}
+
+func (r *pipelineKnownHostResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline_known_host"
+}
+
+func (r *pipelineKnownHostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository_slug": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			"public_key": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *pipelineKnownHostResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
+func (r *pipelineKnownHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan pipelineKnownHostResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	var newPipelineKnownHost client.PipelineKnownHost
+	plan.mapTo(&newPipelineKnownHost)
+
+	pipelineKnownHost, err := r.client.WithWorkspace(plan.Workspace.ValueString()).CreatePipelineKnownHost(plan.RepositorySlug.ValueString(), newPipelineKnownHost)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating pipeline known host",
+			"Could not create pipeline known host, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(pipelineKnownHost)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *pipelineKnownHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state pipelineKnownHostResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	pipelineKnownHost, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetPipelineKnownHost(state.RepositorySlug.ValueString(), state.Uuid.ValueString())
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) {
+			if statusErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Bitbucket Pipeline Known Host",
+			"Could not read Bitbucket pipeline known host "+state.Uuid.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.mapFrom(pipelineKnownHost)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *pipelineKnownHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan pipelineKnownHostResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var newPipelineKnownHost client.PipelineKnownHost
+	plan.mapTo(&newPipelineKnownHost)
+
+	pipelineKnownHost, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdatePipelineKnownHost(plan.RepositorySlug.ValueString(), plan.Uuid.ValueString(), newPipelineKnownHost)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Bitbucket Pipeline Known Host",
+			"Could not update pipeline known host, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(pipelineKnownHost)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *pipelineKnownHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state pipelineKnownHostResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.WithWorkspace(state.Workspace.ValueString()).DeletePipelineKnownHost(state.RepositorySlug.ValueString(), state.Uuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Bitbucket Pipeline Known Host",
+			"Could not delete pipeline known host, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *pipelineKnownHostResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *pipelineKnownHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, importID := splitImportWorkspace(req.ID)
+	idParts := strings.Split(importID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: [workspace/]repository_slug,uuid. Got: %q", req.ID),
+		)
+		return
+	}
+
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), idParts[1])...)
+}
+
+func (m *pipelineKnownHostResourceModel) mapTo(c *client.PipelineKnownHost) {
+	c.Hostname = m.Hostname.ValueString()
+
+	fields := strings.Fields(m.PublicKey.ValueString())
+	if len(fields) > 0 {
+		c.PublicKey.KeyType = fields[0]
+	}
+	if len(fields) > 1 {
+		c.PublicKey.Key = fields[1]
+	}
+}
+
+func (m *pipelineKnownHostResourceModel) mapFrom(c *client.PipelineKnownHost) {
+	m.Uuid = types.StringValue(c.Uuid)
+	m.Hostname = types.StringValue(c.Hostname)
+
+	publicKey := c.PublicKey.KeyType + " " + c.PublicKey.Key
+	if normalizeSSHKey(m.PublicKey.ValueString()) != publicKey {
+		m.PublicKey = types.StringValue(publicKey)
+	}
+}
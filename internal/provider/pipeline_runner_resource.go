@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &pipelineRunnerResource{}
+	_ resource.ResourceWithConfigure   = &pipelineRunnerResource{}
+	_ resource.ResourceWithImportState = &pipelineRunnerResource{}
+	_ resource.ResourceWithModifyPlan  = &pipelineRunnerResource{}
+)
+
+type pipelineRunnerResourceModel struct {
+	Workspace         types.String   `tfsdk:"workspace"`
+	Uuid              types.String   `tfsdk:"uuid"`
+	RepositorySlug    types.String   `tfsdk:"repository_slug"`
+	Name              types.String   `tfsdk:"name"`
+	Labels            []types.String `tfsdk:"labels"`
+	State             types.String   `tfsdk:"state"`
+	OauthClientID     types.String   `tfsdk:"oauth_client_id"`
+	OauthClientSecret types.String   `tfsdk:"oauth_client_secret"`
+}
+
+func NewPipelineRunnerResource() resource.Resource {
+	return &pipelineRunnerResource{}
+}
+
+type pipelineRunnerResource struct {
+	client *client.Client
+}
+
+func (r *pipelineRunnerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline_runner"
+}
+
+func (r *pipelineRunnerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository_slug": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"labels": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"state": schema.StringAttribute{
+				Computed: true,
+			},
+			"oauth_client_id": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"oauth_client_secret": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *pipelineRunnerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
+func (r *pipelineRunnerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan pipelineRunnerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	var newPipelineRunner client.PipelineRunner
+	plan.mapTo(&newPipelineRunner)
+
+	pipelineRunner, err := r.client.WithWorkspace(plan.Workspace.ValueString()).CreatePipelineRunner(plan.RepositorySlug.ValueString(), newPipelineRunner)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating pipeline runner",
+			"Could not create pipeline runner, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(pipelineRunner)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *pipelineRunnerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state pipelineRunnerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	pipelineRunner, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetPipelineRunner(state.RepositorySlug.ValueString(), state.Uuid.ValueString())
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) {
+			if statusErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Bitbucket Pipeline Runner",
+			"Could not read Bitbucket pipeline runner "+state.Uuid.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.mapFrom(pipelineRunner)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *pipelineRunnerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan pipelineRunnerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var newPipelineRunner client.PipelineRunner
+	plan.mapTo(&newPipelineRunner)
+
+	pipelineRunner, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdatePipelineRunner(plan.RepositorySlug.ValueString(), plan.Uuid.ValueString(), newPipelineRunner)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Bitbucket Pipeline Runner",
+			"Could not update pipeline runner, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(pipelineRunner)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *pipelineRunnerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state pipelineRunnerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.WithWorkspace(state.Workspace.ValueString()).DeletePipelineRunner(state.RepositorySlug.ValueString(), state.Uuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Bitbucket Pipeline Runner",
+			"Could not delete pipeline runner, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *pipelineRunnerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *pipelineRunnerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, importID := splitImportWorkspace(req.ID)
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+
+	if !strings.Contains(importID, ",") {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), importID)...)
+		return
+	}
+
+	idParts := strings.Split(importID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: [workspace/]repository_slug,uuid or [workspace/]uuid. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), idParts[1])...)
+}
+
+func (m *pipelineRunnerResourceModel) mapTo(c *client.PipelineRunner) {
+	labels := []string{}
+	for _, label := range m.Labels {
+		labels = append(labels, label.ValueString())
+	}
+
+	c.Name = m.Name.ValueString()
+	c.Labels = labels
+}
+
+func (m *pipelineRunnerResourceModel) mapFrom(c *client.PipelineRunner) {
+	m.Uuid = types.StringValue(c.Uuid)
+	m.Name = types.StringValue(c.Name)
+
+	m.Labels = []types.String{}
+	for _, label := range c.Labels {
+		m.Labels = append(m.Labels, types.StringValue(label))
+	}
+
+	m.State = types.StringNull()
+	if c.State != nil {
+		m.State = types.StringValue(c.State.Status)
+	}
+
+	// The OAuth client secret is only returned when the runner is created, so
+	// the credentials in state are kept on every later read.
+	if c.OauthClient != nil && c.OauthClient.Secret != "" {
+		m.OauthClientID = types.StringValue(c.OauthClient.ID)
+		m.OauthClientSecret = types.StringValue(c.OauthClient.Secret)
+	}
+
+	if m.OauthClientID.IsUnknown() {
+		m.OauthClientID = types.StringNull()
+	}
+	if m.OauthClientSecret.IsUnknown() {
+		m.OauthClientSecret = types.StringNull()
+	}
+}
@@ -0,0 +1,470 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &pipelineScheduleResource{}
+	_ resource.ResourceWithConfigure      = &pipelineScheduleResource{}
+	_ resource.ResourceWithImportState    = &pipelineScheduleResource{}
+	_ resource.ResourceWithModifyPlan     = &pipelineScheduleResource{}
+	_ resource.ResourceWithValidateConfig = &pipelineScheduleResource{}
+)
+
+type pipelineScheduleResourceModel struct {
+	Workspace       types.String `tfsdk:"workspace"`
+	Uuid            types.String `tfsdk:"uuid"`
+	RepositorySlug  types.String `tfsdk:"repository_slug"`
+	RefType         types.String `tfsdk:"ref_type"`
+	RefName         types.String `tfsdk:"ref_name"`
+	SelectorType    types.String `tfsdk:"selector_type"`
+	SelectorPattern types.String `tfsdk:"selector_pattern"`
+	CronPattern     types.String `tfsdk:"cron_pattern"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+}
+
+func NewPipelineScheduleResource() resource.Resource {
+	return &pipelineScheduleResource{}
+}
+
+type pipelineScheduleResource struct {
+	client *client.Client
+}
+
+func (r *pipelineScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline_schedule"
+}
+
+func (r *pipelineScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository_slug": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ref_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("branch"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringOneOf("branch", "tag"),
+				},
+			},
+			"ref_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"selector_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("branches"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringOneOf("branches", "tags", "custom", "default"),
+				},
+			},
+			"selector_pattern": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cron_pattern": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					cronPatternValidator{},
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *pipelineScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
+func (r *pipelineScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config pipelineScheduleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selectorType := "branches"
+	if !config.SelectorType.IsNull() {
+		selectorType = config.SelectorType.ValueString()
+	}
+
+	if (selectorType == "branches" || selectorType == "tags" || selectorType == "custom") && config.SelectorPattern.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("selector_pattern"),
+			"Missing Selector Pattern",
+			fmt.Sprintf("selector_pattern is required when selector_type is %q.", selectorType),
+		)
+	}
+}
+
+func (r *pipelineScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan pipelineScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	var newPipelineSchedule client.PipelineSchedule
+	plan.mapTo(&newPipelineSchedule)
+
+	pipelineSchedule, err := r.client.WithWorkspace(plan.Workspace.ValueString()).CreatePipelineSchedule(plan.RepositorySlug.ValueString(), newPipelineSchedule)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating pipeline schedule",
+			"Could not create pipeline schedule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(pipelineSchedule)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *pipelineScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state pipelineScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	pipelineSchedule, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetPipelineSchedule(state.RepositorySlug.ValueString(), state.Uuid.ValueString())
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) {
+			if statusErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Bitbucket Pipeline Schedule",
+			"Could not read Bitbucket pipeline schedule "+state.Uuid.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.mapFrom(pipelineSchedule)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *pipelineScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan pipelineScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only enabled can be changed in place, every other attribute forces a
+	// new schedule.
+	newPipelineSchedule := client.PipelineSchedule{
+		Enabled: plan.Enabled.ValueBool(),
+	}
+
+	pipelineSchedule, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdatePipelineSchedule(plan.RepositorySlug.ValueString(), plan.Uuid.ValueString(), newPipelineSchedule)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Bitbucket Pipeline Schedule",
+			"Could not update pipeline schedule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(pipelineSchedule)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *pipelineScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state pipelineScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.WithWorkspace(state.Workspace.ValueString()).DeletePipelineSchedule(state.RepositorySlug.ValueString(), state.Uuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Bitbucket Pipeline Schedule",
+			"Could not delete pipeline schedule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *pipelineScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *pipelineScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, importID := splitImportWorkspace(req.ID)
+	idParts := strings.Split(importID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: [workspace/]repository_slug,uuid. Got: %q", req.ID),
+		)
+		return
+	}
+
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), idParts[1])...)
+}
+
+func (m *pipelineScheduleResourceModel) mapTo(c *client.PipelineSchedule) {
+	c.Enabled = m.Enabled.ValueBool()
+	c.CronPattern = m.CronPattern.ValueString()
+	c.Target = &client.PipelineScheduleTarget{
+		Type:    "pipeline_ref_target",
+		RefType: m.RefType.ValueString(),
+		RefName: m.RefName.ValueString(),
+		Selector: client.PipelineScheduleSelector{
+			Type:    m.SelectorType.ValueString(),
+			Pattern: m.SelectorPattern.ValueString(),
+		},
+	}
+}
+
+func (m *pipelineScheduleResourceModel) mapFrom(c *client.PipelineSchedule) {
+	m.Uuid = types.StringValue(c.Uuid)
+	m.Enabled = types.BoolValue(c.Enabled)
+	m.CronPattern = types.StringValue(c.CronPattern)
+
+	if c.Target != nil {
+		m.RefType = types.StringValue(c.Target.RefType)
+		m.RefName = types.StringValue(c.Target.RefName)
+		m.SelectorType = types.StringValue(c.Target.Selector.Type)
+		m.SelectorPattern = types.StringNull()
+		if c.Target.Selector.Pattern != "" {
+			m.SelectorPattern = types.StringValue(c.Target.Selector.Pattern)
+		}
+	}
+}
+
+var _ validator.String = cronPatternValidator{}
+
+type cronField struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+var cronFields = []cronField{
+	{name: "seconds", min: 0, max: 59},
+	{name: "minutes", min: 0, max: 59},
+	{name: "hours", min: 0, max: 23},
+	{name: "day-of-month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day-of-week", min: 1, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+	{name: "year", min: 1970, max: 2099},
+}
+
+type cronPatternValidator struct{}
+
+func (v cronPatternValidator) Description(_ context.Context) string {
+	return "value must be a cron expression with 6 or 7 fields, with ? in either the day-of-month or the day-of-week field"
+}
+
+func (v cronPatternValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronPatternValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	fields := strings.Fields(req.ConfigValue.ValueString())
+	if len(fields) != 6 && len(fields) != 7 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Cron Pattern",
+			fmt.Sprintf("%q has %d fields. Expected seconds, minutes, hours, day-of-month, month, day-of-week and an optional year.", req.ConfigValue.ValueString(), len(fields)),
+		)
+		return
+	}
+
+	for i, field := range fields {
+		if !cronFields[i].valid(field) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Cron Pattern",
+				fmt.Sprintf("%q is not a valid %s field in %q.", field, cronFields[i].name, req.ConfigValue.ValueString()),
+			)
+		}
+	}
+
+	if (fields[3] == "?") == (fields[5] == "?") {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Cron Pattern",
+			fmt.Sprintf("%q must use ? in exactly one of the day-of-month and day-of-week fields.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+func (f cronField) valid(field string) bool {
+	if field == "?" {
+		return f.name == "day-of-month" || f.name == "day-of-week"
+	}
+
+	for _, item := range strings.Split(field, ",") {
+		if !f.validItem(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f cronField) validItem(item string) bool {
+	switch f.name {
+	case "day-of-month":
+		if item == "L" || item == "LW" {
+			return true
+		}
+		if offset, ok := strings.CutPrefix(item, "L-"); ok {
+			n, err := strconv.Atoi(offset)
+			return err == nil && n >= 1 && n <= 30
+		}
+		if day, ok := strings.CutSuffix(item, "W"); ok {
+			return f.validValue(day)
+		}
+	case "day-of-week":
+		if item == "L" {
+			return true
+		}
+		if day, ok := strings.CutSuffix(item, "L"); ok {
+			return f.validValue(day)
+		}
+		if day, nth, ok := strings.Cut(item, "#"); ok {
+			n, err := strconv.Atoi(nth)
+			return f.validValue(day) && err == nil && n >= 1 && n <= 5
+		}
+	}
+
+	if base, step, ok := strings.Cut(item, "/"); ok {
+		n, err := strconv.Atoi(step)
+		if err != nil || n < 1 || n > f.max {
+			return false
+		}
+		if base == "*" {
+			return true
+		}
+		item = base
+	}
+
+	if item == "*" {
+		return true
+	}
+
+	if from, to, ok := strings.Cut(item, "-"); ok {
+		return f.validValue(from) && f.validValue(to)
+	}
+
+	return f.validValue(item)
+}
+
+func (f cronField) validValue(value string) bool {
+	for _, name := range f.names {
+		if strings.EqualFold(value, name) {
+			return true
+		}
+	}
+
+	n, err := strconv.Atoi(value)
+	return err == nil && n >= f.min && n <= f.max
+}
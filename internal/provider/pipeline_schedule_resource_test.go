@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCronPatternValidator(t *testing.T) {
+	tests := []struct {
+		pattern string
+		valid   bool
+	}{
+		{pattern: "0 0 12 * * ?", valid: true},
+		{pattern: "0 15 10 ? * MON-FRI", valid: true},
+		{pattern: "0 0/5 14,18 * * ?", valid: true},
+		{pattern: "0 0 0 L * ?", valid: true},
+		{pattern: "0 0 0 LW * ?", valid: true},
+		{pattern: "0 0 0 L-3 * ?", valid: true},
+		{pattern: "0 0 0 15W * ?", valid: true},
+		{pattern: "0 0 0 ? * 6L", valid: true},
+		{pattern: "0 0 0 ? * 6#3", valid: true},
+		{pattern: "0 0 0 ? jan-mar sun", valid: true},
+		{pattern: "0 0 0 1 * ? 2030", valid: true},
+		{pattern: "*/10 * * * * ?", valid: true},
+		{pattern: "0 0 12 * *", valid: false},
+		{pattern: "0 0 12 * * ? 2030 extra", valid: false},
+		{pattern: "0 0 12 * * *", valid: false},
+		{pattern: "0 0 12 ? * ?", valid: false},
+		{pattern: "60 0 12 * * ?", valid: false},
+		{pattern: "0 0 24 * * ?", valid: false},
+		{pattern: "0 0 0 32 * ?", valid: false},
+		{pattern: "0 0 0 * 13 ?", valid: false},
+		{pattern: "0 0 0 ? * 8", valid: false},
+		{pattern: "0 0 0 ? * 6#6", valid: false},
+		{pattern: "0 0 0 L-31 * ?", valid: false},
+		{pattern: "0 0/0 0 * * ?", valid: false},
+		{pattern: "? 0 0 * * ?", valid: false},
+		{pattern: "0 0 0 1 * ? 1969", valid: false},
+		{pattern: "0 0 0 1 FOO ?", valid: false},
+	}
+
+	for _, test := range tests {
+		req := validator.StringRequest{
+			Path:        path.Root("cron_pattern"),
+			ConfigValue: types.StringValue(test.pattern),
+		}
+		var resp validator.StringResponse
+
+		cronPatternValidator{}.ValidateString(context.Background(), req, &resp)
+
+		if valid := !resp.Diagnostics.HasError(); valid != test.valid {
+			t.Errorf("cron pattern %q valid = %t, want %t: %v", test.pattern, valid, test.valid, resp.Diagnostics)
+		}
+	}
+}
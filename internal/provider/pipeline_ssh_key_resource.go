@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &pipelineSshKeyResource{}
+	_ resource.ResourceWithConfigure   = &pipelineSshKeyResource{}
+	_ resource.ResourceWithImportState = &pipelineSshKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &pipelineSshKeyResource{}
+)
+
+type pipelineSshKeyResourceModel struct {
+	Workspace      types.String `tfsdk:"workspace"`
+	RepositorySlug types.String `tfsdk:"repository_slug"`
+	PrivateKey     types.String `tfsdk:"private_key"`
+	PublicKey      types.String `tfsdk:"public_key"`
+}
+
+func NewPipelineSshKeyResource() resource.Resource {
+	return &pipelineSshKeyResource{}
+}
+
+type pipelineSshKeyResource struct {
+	client *client.Client
+}
+
+func (r *pipelineSshKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline_ssh_key"
+}
+
+func (r *pipelineSshKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"repository_slug": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"public_key": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *pipelineSshKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	// Bitbucket derives the public key from the private key, so a new private
+	// key leaves it unknown until apply.
+	var planPrivateKey, statePrivateKey types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("private_key"), &planPrivateKey)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("private_key"), &statePrivateKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !planPrivateKey.Equal(statePrivateKey) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("public_key"), types.StringUnknown())...)
+	}
+}
+
+func (r *pipelineSshKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan pipelineSshKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	var newPipelineSshKeyPair client.PipelineSshKeyPair
+	plan.mapTo(&newPipelineSshKeyPair)
+
+	pipelineSshKeyPair, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdatePipelineSshKeyPair(plan.RepositorySlug.ValueString(), newPipelineSshKeyPair)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating pipeline SSH key",
+			"Could not create pipeline SSH key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(pipelineSshKeyPair)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *pipelineSshKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state pipelineSshKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	pipelineSshKeyPair, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetPipelineSshKeyPair(state.RepositorySlug.ValueString())
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) {
+			if statusErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Bitbucket Pipeline SSH Key",
+			"Could not read Bitbucket pipeline SSH key for repository "+state.RepositorySlug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.mapFrom(pipelineSshKeyPair)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *pipelineSshKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan pipelineSshKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var newPipelineSshKeyPair client.PipelineSshKeyPair
+	plan.mapTo(&newPipelineSshKeyPair)
+
+	pipelineSshKeyPair, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdatePipelineSshKeyPair(plan.RepositorySlug.ValueString(), newPipelineSshKeyPair)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Bitbucket Pipeline SSH Key",
+			"Could not update pipeline SSH key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(pipelineSshKeyPair)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *pipelineSshKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state pipelineSshKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.WithWorkspace(state.Workspace.ValueString()).DeletePipelineSshKeyPair(state.RepositorySlug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Bitbucket Pipeline SSH Key",
+			"Could not delete pipeline SSH key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *pipelineSshKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *pipelineSshKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, repositorySlug := splitImportWorkspace(req.ID)
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), repositorySlug)...)
+}
+
+func (m *pipelineSshKeyResourceModel) mapTo(c *client.PipelineSshKeyPair) {
+	c.PrivateKey = m.PrivateKey.ValueString()
+}
+
+// mapFrom never touches the private key, which Bitbucket does not return.
+func (m *pipelineSshKeyResourceModel) mapFrom(c *client.PipelineSshKeyPair) {
+	m.PublicKey = stringValueOrNull(c.PublicKey)
+}
@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &pipelinesConfigResource{}
+	_ resource.ResourceWithConfigure   = &pipelinesConfigResource{}
+	_ resource.ResourceWithImportState = &pipelinesConfigResource{}
+	_ resource.ResourceWithModifyPlan  = &pipelinesConfigResource{}
+)
+
+type pipelinesConfigResourceModel struct {
+	Workspace      types.String `tfsdk:"workspace"`
+	RepositorySlug types.String `tfsdk:"repository_slug"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+}
+
+func NewPipelinesConfigResource() resource.Resource {
+	return &pipelinesConfigResource{}
+}
+
+type pipelinesConfigResource struct {
+	client *client.Client
+}
+
+func (r *pipelinesConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipelines_config"
+}
+
+func (r *pipelinesConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"repository_slug": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *pipelinesConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
+func (r *pipelinesConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan pipelinesConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	var newPipelinesConfig client.PipelinesConfig
+	plan.mapTo(&newPipelinesConfig)
+
+	pipelinesConfig, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdatePipelinesConfig(plan.RepositorySlug.ValueString(), newPipelinesConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating pipelines config",
+			"Could not update pipelines config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(pipelinesConfig)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *pipelinesConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state pipelinesConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	pipelinesConfig, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetPipelinesConfig(state.RepositorySlug.ValueString())
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) {
+			if statusErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Bitbucket Pipelines Config",
+			"Could not read Bitbucket pipelines config for repository "+state.RepositorySlug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.mapFrom(pipelinesConfig)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *pipelinesConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan pipelinesConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var newPipelinesConfig client.PipelinesConfig
+	plan.mapTo(&newPipelinesConfig)
+
+	pipelinesConfig, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdatePipelinesConfig(plan.RepositorySlug.ValueString(), newPipelinesConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Bitbucket Pipelines Config",
+			"Could not update pipelines config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(pipelinesConfig)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *pipelinesConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state pipelinesConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.WithWorkspace(state.Workspace.ValueString()).UpdatePipelinesConfig(state.RepositorySlug.ValueString(), client.PipelinesConfig{Enabled: false})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Bitbucket Pipelines Config",
+			"Could not disable pipelines, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *pipelinesConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *pipelinesConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, repositorySlug := splitImportWorkspace(req.ID)
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), repositorySlug)...)
+}
+
+func (m *pipelinesConfigResourceModel) mapTo(c *client.PipelinesConfig) {
+	c.Enabled = m.Enabled.ValueBool()
+}
+
+func (m *pipelinesConfigResourceModel) mapFrom(c *client.PipelinesConfig) {
+	m.Enabled = types.BoolValue(c.Enabled)
+}
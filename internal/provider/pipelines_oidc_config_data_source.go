@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &pipelinesOidcConfigDataSource{}
+	_ datasource.DataSourceWithConfigure = &pipelinesOidcConfigDataSource{}
+)
+
+type pipelinesOidcKeyModel struct {
+	Kty types.String `tfsdk:"kty"`
+	Kid types.String `tfsdk:"kid"`
+	Use types.String `tfsdk:"use"`
+	Alg types.String `tfsdk:"alg"`
+	N   types.String `tfsdk:"n"`
+	E   types.String `tfsdk:"e"`
+}
+
+type pipelinesOidcConfigDataSourceModel struct {
+	Workspace types.String            `tfsdk:"workspace"`
+	IssuerUrl types.String            `tfsdk:"issuer_url"`
+	Audience  types.String            `tfsdk:"audience"`
+	JwksUri   types.String            `tfsdk:"jwks_uri"`
+	Jwks      types.String            `tfsdk:"jwks"`
+	Keys      []pipelinesOidcKeyModel `tfsdk:"keys"`
+}
+
+func NewPipelinesOidcConfigDataSource() datasource.DataSource {
+	return &pipelinesOidcConfigDataSource{}
+}
+
+type pipelinesOidcConfigDataSource struct {
+	client *client.Client
+}
+
+func (d *pipelinesOidcConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipelines_oidc_config"
+}
+
+func (d *pipelinesOidcConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+			},
+			"issuer_url": schema.StringAttribute{
+				Computed: true,
+			},
+			"audience": schema.StringAttribute{
+				Computed: true,
+			},
+			"jwks_uri": schema.StringAttribute{
+				Computed: true,
+			},
+			"jwks": schema.StringAttribute{
+				Computed: true,
+			},
+			"keys": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kty": schema.StringAttribute{
+							Computed: true,
+						},
+						"kid": schema.StringAttribute{
+							Computed: true,
+						},
+						"use": schema.StringAttribute{
+							Computed: true,
+						},
+						"alg": schema.StringAttribute{
+							Computed: true,
+						},
+						"n": schema.StringAttribute{
+							Computed: true,
+						},
+						"e": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *pipelinesOidcConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state pipelinesOidcConfigDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := d.client.WithWorkspace(state.Workspace.ValueString())
+
+	workspace, err := c.GetWorkspace(c.Workspace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Bitbucket Workspace",
+			"Could not read Bitbucket workspace "+c.Workspace+": "+err.Error(),
+		)
+		return
+	}
+
+	oidcConfiguration, err := c.GetPipelinesOidcConfiguration()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Bitbucket Pipelines OIDC Configuration",
+			"Could not read Bitbucket Pipelines OIDC configuration: "+err.Error(),
+		)
+		return
+	}
+
+	oidcKeys, err := c.GetPipelinesOidcKeys()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Bitbucket Pipelines OIDC Keys",
+			"Could not read Bitbucket Pipelines OIDC keys: "+err.Error(),
+		)
+		return
+	}
+
+	state.IssuerUrl = types.StringValue(oidcConfiguration.Issuer)
+	state.JwksUri = types.StringValue(oidcConfiguration.JwksUri)
+	state.Audience = types.StringValue("ari:cloud:bitbucket::workspace/" + strings.Trim(workspace.Uuid, "{}"))
+	state.Jwks = types.StringValue(oidcKeys.Raw)
+
+	state.Keys = []pipelinesOidcKeyModel{}
+	for _, key := range oidcKeys.Keys {
+		state.Keys = append(state.Keys, pipelinesOidcKeyModel{
+			Kty: types.StringValue(key.Kty),
+			Kid: types.StringValue(key.Kid),
+			Use: types.StringValue(key.Use),
+			Alg: types.StringValue(key.Alg),
+			N:   types.StringValue(key.N),
+			E:   types.StringValue(key.E),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *pipelinesOidcConfigDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &projectBranchingModelResource{}
+	_ resource.ResourceWithConfigure      = &projectBranchingModelResource{}
+	_ resource.ResourceWithImportState    = &projectBranchingModelResource{}
+	_ resource.ResourceWithModifyPlan     = &projectBranchingModelResource{}
+	_ resource.ResourceWithValidateConfig = &projectBranchingModelResource{}
+)
+
+type projectBranchingModelResourceModel struct {
+	Workspace   types.String               `tfsdk:"workspace"`
+	ProjectKey  types.String               `tfsdk:"project_key"`
+	Development *branchingModelBranchModel `tfsdk:"development"`
+	Production  *branchingModelBranchModel `tfsdk:"production"`
+	BranchTypes []branchTypeModel          `tfsdk:"branch_types"`
+}
+
+func NewProjectBranchingModelResource() resource.Resource {
+	return &projectBranchingModelResource{}
+}
+
+type projectBranchingModelResource struct {
+	client *client.Client
+}
+
+func (r *projectBranchingModelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_branching_model"
+}
+
+func (r *projectBranchingModelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := branchingModelSchemaAttributes()
+	attributes["project_key"] = schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (r *projectBranchingModelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
+func (r *projectBranchingModelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateBranchingModelBranch(ctx, req.Config, "development")...)
+	resp.Diagnostics.Append(validateBranchingModelBranch(ctx, req.Config, "production")...)
+}
+
+func (r *projectBranchingModelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan projectBranchingModelResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	newSettings := branchingModelSettingsFrom(plan.Development, plan.Production, plan.BranchTypes)
+
+	settings, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdateProjectBranchingModelSettings(plan.ProjectKey.ValueString(), newSettings)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating project branching model",
+			"Could not update branching model settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Development, plan.Production, plan.BranchTypes = branchingModelFrom(settings, plan.BranchTypes)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *projectBranchingModelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state projectBranchingModelResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	settings, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetProjectBranchingModelSettings(state.ProjectKey.ValueString())
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) {
+			if statusErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Bitbucket Project Branching Model",
+			"Could not read Bitbucket branching model for project "+state.ProjectKey.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Development, state.Production, state.BranchTypes = branchingModelFrom(settings, state.BranchTypes)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *projectBranchingModelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan projectBranchingModelResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newSettings := branchingModelSettingsFrom(plan.Development, plan.Production, plan.BranchTypes)
+
+	settings, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdateProjectBranchingModelSettings(plan.ProjectKey.ValueString(), newSettings)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Bitbucket Project Branching Model",
+			"Could not update branching model settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Development, plan.Production, plan.BranchTypes = branchingModelFrom(settings, plan.BranchTypes)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *projectBranchingModelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state projectBranchingModelResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.WithWorkspace(state.Workspace.ValueString()).UpdateProjectBranchingModelSettings(state.ProjectKey.ValueString(), defaultBranchingModelSettings())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Bitbucket Project Branching Model",
+			"Could not reset branching model settings to defaults, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *projectBranchingModelResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *projectBranchingModelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, projectKey := splitImportWorkspace(req.ID)
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), projectKey)...)
+}
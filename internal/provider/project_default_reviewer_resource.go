@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &projectDefaultReviewerResource{}
+	_ resource.ResourceWithConfigure   = &projectDefaultReviewerResource{}
+	_ resource.ResourceWithImportState = &projectDefaultReviewerResource{}
+	_ resource.ResourceWithModifyPlan  = &projectDefaultReviewerResource{}
+)
+
+type projectDefaultReviewerResourceModel struct {
+	Workspace  types.String `tfsdk:"workspace"`
+	ProjectKey types.String `tfsdk:"project_key"`
+	User       types.String `tfsdk:"user"`
+}
+
+func NewProjectDefaultReviewerResource() resource.Resource {
+	return &projectDefaultReviewerResource{}
+}
+
+type projectDefaultReviewerResource struct {
+	client *client.Client
+}
+
+func (r *projectDefaultReviewerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_default_reviewer"
+}
+
+func (r *projectDefaultReviewerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: defaultReviewerSchemaAttributes("project_key"),
+	}
+}
+
+func (r *projectDefaultReviewerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
+func (r *projectDefaultReviewerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan projectDefaultReviewerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	defaultReviewer, diags := createDefaultReviewer("project default reviewer", func() (*client.User, error) {
+		return r.client.WithWorkspace(plan.Workspace.ValueString()).CreateProjectDefaultReviewer(plan.ProjectKey.ValueString(), plan.User.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.mapFrom(defaultReviewer)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *projectDefaultReviewerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state projectDefaultReviewerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	defaultReviewer, diags := readDefaultReviewer("Project Default Reviewer", "project "+state.ProjectKey.ValueString(), func() (*client.User, error) {
+		return r.client.WithWorkspace(state.Workspace.ValueString()).GetProjectDefaultReviewer(state.ProjectKey.ValueString(), state.User.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if defaultReviewer == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.mapFrom(defaultReviewer)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *projectDefaultReviewerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan projectDefaultReviewerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *projectDefaultReviewerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state projectDefaultReviewerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = deleteDefaultReviewer("Project Default Reviewer", func() error {
+		return r.client.WithWorkspace(state.Workspace.ValueString()).DeleteProjectDefaultReviewer(state.ProjectKey.ValueString(), state.User.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *projectDefaultReviewerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *projectDefaultReviewerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, importID := splitImportWorkspace(req.ID)
+	idParts := strings.Split(importID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: [workspace/]project_key,user. Got: %q", req.ID),
+		)
+		return
+	}
+
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), idParts[1])...)
+}
+
+func (m *projectDefaultReviewerResourceModel) mapFrom(c *client.User) {
+	m.User = defaultReviewerUser(m.User, c)
+}
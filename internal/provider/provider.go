@@ -2,10 +2,13 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"os"
+	"strings"
 
 	"github.com/afagund/terraform-provider-bitbucket/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -31,9 +34,16 @@ type bitbucketProvider struct {
 }
 
 type bitbucketProviderModel struct {
-	Host      types.String `tfsdk:"host"`
-	Workspace types.String `tfsdk:"workspace"`
-	Token     types.String `tfsdk:"token"`
+	Host                            types.String `tfsdk:"host"`
+	Workspace                       types.String `tfsdk:"workspace"`
+	Token                           types.String `tfsdk:"token"`
+	AdoptExistingBranchRestrictions types.Bool   `tfsdk:"adopt_existing_branch_restrictions"`
+	VerifyWorkspace                 types.Bool   `tfsdk:"verify_workspace"`
+}
+
+type bitbucketProviderData struct {
+	client                          *client.Client
+	adoptExistingBranchRestrictions bool
 }
 
 func (p *bitbucketProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -54,6 +64,12 @@ func (p *bitbucketProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:  true,
 				Sensitive: true,
 			},
+			"adopt_existing_branch_restrictions": schema.BoolAttribute{
+				Optional: true,
+			},
+			"verify_workspace": schema.BoolAttribute{
+				Optional: true,
+			},
 		},
 	}
 }
@@ -167,15 +183,121 @@ func (p *bitbucketProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	if config.VerifyWorkspace.ValueBool() {
+		resp.Diagnostics.Append(verifyWorkspace(client, workspace)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data := &bitbucketProviderData{
+		client:                          client,
+		adoptExistingBranchRestrictions: true,
+	}
+
+	if !config.AdoptExistingBranchRestrictions.IsNull() {
+		data.adoptExistingBranchRestrictions = config.AdoptExistingBranchRestrictions.ValueBool()
+	}
+
+	resp.DataSourceData = data
+	resp.ResourceData = data
 
 	tflog.Info(ctx, "Configured Bitbucket client", map[string]any{"success": true})
 }
 
+func verifyWorkspace(c *client.Client, workspace string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	_, err := c.GetWorkspace(workspace)
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) && statusErr.StatusCode == 404 {
+			diags.AddAttributeError(
+				path.Root("workspace"),
+				"Unknown Bitbucket API Workspace",
+				"The provider could not find the Bitbucket workspace "+workspace+". "+
+					"Ensure the workspace slug is correct and that the configured token can access it.",
+			)
+			return diags
+		}
+
+		diags.AddAttributeError(
+			path.Root("workspace"),
+			"Unable to Access Bitbucket API Workspace",
+			"The provider could not verify access to the Bitbucket workspace "+workspace+" with the configured token. "+
+				"Ensure the token is valid and has permission to read the workspace.\n\n"+
+				"Bitbucket Client Error: "+err.Error(),
+		)
+	}
+
+	return diags
+}
+
+func splitImportWorkspace(id string) (string, string) {
+	prefix := id
+	if i := strings.IndexAny(id, ",:"); i >= 0 {
+		prefix = id[:i]
+	}
+
+	if i := strings.Index(prefix, "/"); i >= 0 {
+		return id[:i], id[i+1:]
+	}
+
+	return "", id
+}
+
+func workspaceValue(c *client.Client, workspace types.String) types.String {
+	if workspace.IsNull() || workspace.IsUnknown() {
+		return types.StringValue(c.Workspace)
+	}
+
+	return workspace
+}
+
+// modifyWorkspacePlan plans the resolved workspace of a resource and only
+// forces a replacement when it differs from the workspace in state, so that
+// spelling out the provider's default workspace does not recreate anything.
+func modifyWorkspacePlan(ctx context.Context, c *client.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if c == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var workspace types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("workspace"), &workspace)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !workspace.IsUnknown() {
+		workspace = workspaceValue(c, workspace)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var stateWorkspace types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("workspace"), &stateWorkspace)...)
+	if resp.Diagnostics.HasError() || stateWorkspace.IsNull() {
+		return
+	}
+
+	if workspace.IsUnknown() || !workspace.Equal(stateWorkspace) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("workspace"))
+	}
+}
+
 func (p *bitbucketProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewRepositoryDataSource,
+		NewBranchRestrictionsDataSource,
+		NewUserDataSource,
+		NewCurrentUserDataSource,
+		NewWorkspaceMembersDataSource,
+		NewWorkspaceDataSource,
+		NewPipelinesOidcConfigDataSource,
+		NewUserSshKeysDataSource,
 	}
 }
 
@@ -184,5 +306,27 @@ func (p *bitbucketProvider) Resources(_ context.Context) []func() resource.Resou
 		NewRepositoryResource,
 		NewGroupPermissionResource,
 		NewBranchRestrictionResource,
+		NewRepositoryBranchRestrictionsResource,
+		NewDeployKeyResource,
+		NewWebhookResource,
+		NewDefaultReviewerResource,
+		NewDefaultReviewersResource,
+		NewProjectDefaultReviewerResource,
+		NewBranchingModelResource,
+		NewProjectBranchingModelResource,
+		NewPipelinesConfigResource,
+		NewRepositoryVariableResource,
+		NewWorkspaceVariableResource,
+		NewDeploymentResource,
+		NewDeploymentVariableResource,
+		NewPipelineScheduleResource,
+		NewPipelineSshKeyResource,
+		NewPipelineKnownHostResource,
+		NewPipelineRunnerResource,
+		NewUserSshKeyResource,
+		NewRepositoryAccessTokenResource,
+		NewProjectAccessTokenResource,
+		NewWorkspaceAccessTokenResource,
+		NewPullRequestSettingsResource,
 	}
 }
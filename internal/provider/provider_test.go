@@ -0,0 +1,27 @@
+package provider
+
+import "testing"
+
+func TestSplitImportWorkspace(t *testing.T) {
+	tests := []struct {
+		id        string
+		workspace string
+		importID  string
+	}{
+		{id: "repo", workspace: "", importID: "repo"},
+		{id: "ws/repo", workspace: "ws", importID: "repo"},
+		{id: "repo,1", workspace: "", importID: "repo,1"},
+		{id: "ws/repo,1", workspace: "ws", importID: "repo,1"},
+		{id: "repo:push:release/*", workspace: "", importID: "repo:push:release/*"},
+		{id: "ws/repo:push:release/*", workspace: "ws", importID: "repo:push:release/*"},
+		{id: "repo,feature/x", workspace: "", importID: "repo,feature/x"},
+		{id: "", workspace: "", importID: ""},
+	}
+
+	for _, test := range tests {
+		workspace, importID := splitImportWorkspace(test.id)
+		if workspace != test.workspace || importID != test.importID {
+			t.Errorf("splitImportWorkspace(%q) = %q, %q, want %q, %q", test.id, workspace, importID, test.workspace, test.importID)
+		}
+	}
+}
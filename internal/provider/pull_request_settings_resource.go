@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &pullRequestSettingsResource{}
+	_ resource.ResourceWithConfigure      = &pullRequestSettingsResource{}
+	_ resource.ResourceWithImportState    = &pullRequestSettingsResource{}
+	_ resource.ResourceWithValidateConfig = &pullRequestSettingsResource{}
+	_ resource.ResourceWithModifyPlan     = &pullRequestSettingsResource{}
+)
+
+var mergeStrategies = []string{
+	"merge_commit",
+	"squash",
+	"fast_forward",
+	"squash_fast_forward",
+	"rebase_fast_forward",
+	"rebase_merge",
+}
+
+type pullRequestSettingsResourceModel struct {
+	Workspace                    types.String   `tfsdk:"workspace"`
+	RepositorySlug               types.String   `tfsdk:"repository_slug"`
+	MergeStrategies              []types.String `tfsdk:"merge_strategies"`
+	DefaultMergeStrategy         types.String   `tfsdk:"default_merge_strategy"`
+	DeleteSourceBranchAfterMerge types.Bool     `tfsdk:"delete_source_branch_after_merge"`
+}
+
+func NewPullRequestSettingsResource() resource.Resource {
+	return &pullRequestSettingsResource{}
+}
+
+type pullRequestSettingsResource struct {
+	client *client.Client
+}
+
+func (r *pullRequestSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_pull_request_settings"
+}
+
+func (r *pullRequestSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"repository_slug": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"merge_strategies": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"default_merge_strategy": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringOneOf(mergeStrategies...),
+				},
+			},
+			"delete_source_branch_after_merge": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *pullRequestSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var defaultMergeStrategy types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("default_merge_strategy"), &defaultMergeStrategy)...)
+
+	var mergeStrategiesValue types.Set
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("merge_strategies"), &mergeStrategiesValue)...)
+	if resp.Diagnostics.HasError() || !defaultMergeStrategy.IsUnknown() || mergeStrategiesValue.IsUnknown() {
+		return
+	}
+
+	// Without an explicit default, a single allowed strategy is the default,
+	// otherwise it is left to Bitbucket.
+	elements := mergeStrategiesValue.Elements()
+	if len(elements) == 1 && !elements[0].IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("default_merge_strategy"), elements[0])...)
+	}
+}
+
+func (r *pullRequestSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var mergeStrategiesValue types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("merge_strategies"), &mergeStrategiesValue)...)
+	if resp.Diagnostics.HasError() || mergeStrategiesValue.IsNull() || mergeStrategiesValue.IsUnknown() {
+		return
+	}
+
+	var config pullRequestSettingsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.DefaultMergeStrategy.IsUnknown() {
+		return
+	}
+
+	defaultMergeStrategy := config.DefaultMergeStrategy.ValueString()
+
+	allowed := false
+	for _, mergeStrategy := range config.MergeStrategies {
+		if mergeStrategy.IsUnknown() {
+			return
+		}
+
+		if !isMergeStrategy(mergeStrategy.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("merge_strategies"),
+				"Invalid Merge Strategy",
+				fmt.Sprintf("%q is not a Bitbucket merge strategy. Expected one of: %s.", mergeStrategy.ValueString(), strings.Join(mergeStrategies, ", ")),
+			)
+		}
+
+		if mergeStrategy.ValueString() == defaultMergeStrategy {
+			allowed = true
+		}
+	}
+
+	if !allowed && !config.DefaultMergeStrategy.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_merge_strategy"),
+			"Invalid Default Merge Strategy",
+			fmt.Sprintf("The default merge strategy %q must be one of the allowed merge_strategies.", defaultMergeStrategy),
+		)
+	}
+}
+
+func (r *pullRequestSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan pullRequestSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	var newPullRequestSettings client.PullRequestSettings
+	plan.mapTo(&newPullRequestSettings)
+
+	pullRequestSettings, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdatePullRequestSettings(plan.RepositorySlug.ValueString(), newPullRequestSettings)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating pull request settings",
+			"Could not update pull request settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(pullRequestSettings)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *pullRequestSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state pullRequestSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	pullRequestSettings, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetPullRequestSettings(state.RepositorySlug.ValueString())
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) {
+			if statusErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Bitbucket Pull Request Settings",
+			"Could not read Bitbucket pull request settings for repository "+state.RepositorySlug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.mapFrom(pullRequestSettings)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *pullRequestSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan pullRequestSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var newPullRequestSettings client.PullRequestSettings
+	plan.mapTo(&newPullRequestSettings)
+
+	pullRequestSettings, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdatePullRequestSettings(plan.RepositorySlug.ValueString(), newPullRequestSettings)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Bitbucket Pull Request Settings",
+			"Could not update pull request settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(pullRequestSettings)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete only removes the settings from state. Bitbucket has no way to unset
+// them, and the values from before the first apply are not known.
+func (r *pullRequestSettingsResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *pullRequestSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *pullRequestSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, repositorySlug := splitImportWorkspace(req.ID)
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), repositorySlug)...)
+}
+
+func (m *pullRequestSettingsResourceModel) mapTo(c *client.PullRequestSettings) {
+	c.MergeStrategies = []string{}
+	for _, mergeStrategy := range m.MergeStrategies {
+		c.MergeStrategies = append(c.MergeStrategies, mergeStrategy.ValueString())
+	}
+
+	c.DefaultMergeStrategy = m.DefaultMergeStrategy.ValueString()
+	if c.DefaultMergeStrategy == "" && len(c.MergeStrategies) == 1 {
+		c.DefaultMergeStrategy = c.MergeStrategies[0]
+	}
+	c.DeleteSourceBranchAfterMerge = m.DeleteSourceBranchAfterMerge.ValueBool()
+}
+
+func (m *pullRequestSettingsResourceModel) mapFrom(c *client.PullRequestSettings) {
+	m.MergeStrategies = []types.String{}
+	for _, mergeStrategy := range c.MergeStrategies {
+		m.MergeStrategies = append(m.MergeStrategies, types.StringValue(mergeStrategy))
+	}
+
+	m.DefaultMergeStrategy = types.StringValue(c.DefaultMergeStrategy)
+	m.DeleteSourceBranchAfterMerge = types.BoolValue(c.DeleteSourceBranchAfterMerge)
+}
+
+func isMergeStrategy(value string) bool {
+	for _, mergeStrategy := range mergeStrategies {
+		if value == mergeStrategy {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &repositoryAccessTokenResource{}
+	_ resource.ResourceWithConfigure   = &repositoryAccessTokenResource{}
+	_ resource.ResourceWithImportState = &repositoryAccessTokenResource{}
+	_ resource.ResourceWithModifyPlan  = &repositoryAccessTokenResource{}
+)
+
+type repositoryAccessTokenResourceModel struct {
+	Workspace      types.String   `tfsdk:"workspace"`
+	ID             types.String   `tfsdk:"id"`
+	RepositorySlug types.String   `tfsdk:"repository_slug"`
+	Name           types.String   `tfsdk:"name"`
+	Scopes         []types.String `tfsdk:"scopes"`
+	ExpiresAt      types.String   `tfsdk:"expires_at"`
+	Token          types.String   `tfsdk:"token"`
+}
+
+func NewRepositoryAccessTokenResource() resource.Resource {
+	return &repositoryAccessTokenResource{}
+}
+
+type repositoryAccessTokenResource struct {
+	client *client.Client
+}
+
+func (r *repositoryAccessTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_access_token"
+}
+
+func (r *repositoryAccessTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := accessTokenSchemaAttributes()
+	attributes["repository_slug"] = schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (r *repositoryAccessTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
+func (r *repositoryAccessTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan repositoryAccessTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	newAccessToken := accessTokenFrom(plan.Name, plan.Scopes, plan.ExpiresAt)
+
+	accessToken, err := r.client.WithWorkspace(plan.Workspace.ValueString()).CreateRepositoryAccessToken(plan.RepositorySlug.ValueString(), newAccessToken)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating repository access token",
+			"Could not create repository access token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Token = types.StringValue(accessToken.Token)
+	plan.ID, plan.Name, plan.Scopes, plan.ExpiresAt = accessTokenAttributesFrom(accessToken, plan.ExpiresAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *repositoryAccessTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state repositoryAccessTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	accessToken, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetRepositoryAccessToken(state.RepositorySlug.ValueString(), state.ID.ValueString())
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) {
+			if statusErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Bitbucket Repository Access Token",
+			"Could not read Bitbucket repository access token "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID, state.Name, state.Scopes, state.ExpiresAt = accessTokenAttributesFrom(accessToken, state.ExpiresAt)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *repositoryAccessTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan repositoryAccessTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *repositoryAccessTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state repositoryAccessTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.WithWorkspace(state.Workspace.ValueString()).DeleteRepositoryAccessToken(state.RepositorySlug.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Bitbucket Repository Access Token",
+			"Could not delete repository access token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *repositoryAccessTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *repositoryAccessTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, importID := splitImportWorkspace(req.ID)
+	idParts := strings.Split(importID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: [workspace/]repository_slug,id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+// accessTokenSchemaAttributes returns the attributes shared by the repository,
+// project and workspace access tokens. Bitbucket access tokens cannot be
+// modified once created, so every attribute forces a new token.
+func accessTokenSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"workspace": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"scopes": schema.SetAttribute{
+			Required:    true,
+			ElementType: types.StringType,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.RequiresReplace(),
+			},
+		},
+		"expires_at": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"token": schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func accessTokenFrom(name types.String, scopes []types.String, expiresAt types.String) client.AccessToken {
+	accessToken := client.AccessToken{
+		Name:      name.ValueString(),
+		Scopes:    []string{},
+		ExpiresAt: expiresAt.ValueString(),
+	}
+
+	for _, scope := range scopes {
+		accessToken.Scopes = append(accessToken.Scopes, scope.ValueString())
+	}
+
+	return accessToken
+}
+
+// accessTokenAttributesFrom maps an access token back to its attributes. The
+// token itself is only returned on creation and is left to the caller.
+func accessTokenAttributesFrom(c *client.AccessToken, expiresAt types.String) (types.String, types.String, []types.String, types.String) {
+	scopes := []types.String{}
+	for _, scope := range c.Scopes {
+		scopes = append(scopes, types.StringValue(scope))
+	}
+
+	if expiresAt.IsUnknown() || !sameTime(expiresAt.ValueString(), c.ExpiresAt) {
+		expiresAt = types.StringNull()
+		if c.ExpiresAt != "" {
+			expiresAt = types.StringValue(c.ExpiresAt)
+		}
+	}
+
+	return types.StringValue(c.ID), types.StringValue(c.Name), scopes, expiresAt
+}
+
+func sameTime(a, b string) bool {
+	timeA, err := time.Parse(time.RFC3339, a)
+	if err != nil {
+		return a == b
+	}
+
+	timeB, err := time.Parse(time.RFC3339, b)
+	if err != nil {
+		return a == b
+	}
+
+	return timeA.Equal(timeB)
+}
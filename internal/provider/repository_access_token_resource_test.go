@@ -0,0 +1,24 @@
+package provider
+
+import "testing"
+
+func TestSameTime(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{a: "2026-01-01T00:00:00Z", b: "2026-01-01T00:00:00Z", want: true},
+		{a: "2026-01-01T00:00:00Z", b: "2026-01-01T00:00:00.000000+00:00", want: true},
+		{a: "2026-01-01T02:00:00+02:00", b: "2026-01-01T00:00:00Z", want: true},
+		{a: "2026-01-01T00:00:00Z", b: "2026-01-02T00:00:00Z", want: false},
+		{a: "not a time", b: "not a time", want: true},
+		{a: "not a time", b: "2026-01-01T00:00:00Z", want: false},
+		{a: "", b: "", want: true},
+	}
+
+	for _, test := range tests {
+		if got := sameTime(test.a, test.b); got != test.want {
+			t.Errorf("sameTime(%q, %q) = %t, want %t", test.a, test.b, got, test.want)
+		}
+	}
+}
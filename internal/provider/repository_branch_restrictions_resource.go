@@ -0,0 +1,486 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &repositoryBranchRestrictionsResource{}
+	_ resource.ResourceWithConfigure   = &repositoryBranchRestrictionsResource{}
+	_ resource.ResourceWithImportState = &repositoryBranchRestrictionsResource{}
+	_ resource.ResourceWithModifyPlan  = &repositoryBranchRestrictionsResource{}
+)
+
+type userUuidModel struct {
+	Uuid types.String `tfsdk:"uuid"`
+}
+
+type repositoryBranchRestrictionModel struct {
+	Kind            types.String    `tfsdk:"kind"`
+	BranchMatchKind types.String    `tfsdk:"branch_match_kind"`
+	BranchType      types.String    `tfsdk:"branch_type"`
+	Pattern         types.String    `tfsdk:"pattern"`
+	Value           types.Int64     `tfsdk:"value"`
+	Users           []userUuidModel `tfsdk:"users"`
+	Groups          []groupModel    `tfsdk:"groups"`
+}
+
+type repositoryBranchRestrictionsResourceModel struct {
+	Workspace      types.String                       `tfsdk:"workspace"`
+	RepositorySlug types.String                       `tfsdk:"repository_slug"`
+	Restrictions   []repositoryBranchRestrictionModel `tfsdk:"restrictions"`
+}
+
+func NewRepositoryBranchRestrictionsResource() resource.Resource {
+	return &repositoryBranchRestrictionsResource{}
+}
+
+type repositoryBranchRestrictionsResource struct {
+	client *client.Client
+}
+
+func (r *repositoryBranchRestrictionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_branch_restrictions"
+}
+
+func (r *repositoryBranchRestrictionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"repository_slug": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"restrictions": schema.SetNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kind": schema.StringAttribute{
+							Required: true,
+						},
+						"branch_match_kind": schema.StringAttribute{
+							Required: true,
+						},
+						"branch_type": schema.StringAttribute{
+							Optional: true,
+						},
+						"pattern": schema.StringAttribute{
+							Required: true,
+						},
+						"value": schema.Int64Attribute{
+							Optional: true,
+						},
+						"users": schema.SetNestedAttribute{
+							Optional: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"uuid": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"groups": schema.SetNestedAttribute{
+							Optional: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"slug": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *repositoryBranchRestrictionsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
+func (r *repositoryBranchRestrictionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan repositoryBranchRestrictionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	resp.Diagnostics.Append(r.apply(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *repositoryBranchRestrictionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state repositoryBranchRestrictionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	branchRestrictions, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetBranchRestrictions(state.RepositorySlug.ValueString())
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) {
+			if statusErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Bitbucket Branch Restrictions",
+			"Could not read Bitbucket branch restrictions for repository "+state.RepositorySlug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.mapFrom(branchRestrictions.Values)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *repositoryBranchRestrictionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan repositoryBranchRestrictionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *repositoryBranchRestrictionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state repositoryBranchRestrictionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	branchRestrictions, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetBranchRestrictions(state.RepositorySlug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Bitbucket Branch Restrictions",
+			"Could not read branch restrictions, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	managed := map[string]bool{}
+	for _, restriction := range state.Restrictions {
+		var branchRestriction client.BranchRestriction
+		restriction.mapTo(&branchRestriction)
+		managed[branchRestrictionKey(branchRestriction)] = true
+	}
+
+	for _, branchRestriction := range branchRestrictions.Values {
+		if !managed[branchRestrictionKey(branchRestriction)] {
+			continue
+		}
+
+		err := r.client.WithWorkspace(state.Workspace.ValueString()).DeleteBranchRestriction(state.RepositorySlug.ValueString(), branchRestriction.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting Bitbucket Branch Restrictions",
+				"Could not delete branch restriction, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *repositoryBranchRestrictionsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *repositoryBranchRestrictionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, repositorySlug := splitImportWorkspace(req.ID)
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), repositorySlug)...)
+}
+
+func (r *repositoryBranchRestrictionsResource) apply(plan *repositoryBranchRestrictionsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := r.client.WithWorkspace(plan.Workspace.ValueString())
+	repositorySlug := plan.RepositorySlug.ValueString()
+
+	branchRestrictions, err := c.GetBranchRestrictions(repositorySlug)
+	if err != nil {
+		diags.AddError(
+			"Error Applying Bitbucket Branch Restrictions",
+			"Could not read branch restrictions, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	existing := map[string][]client.BranchRestriction{}
+	for _, branchRestriction := range branchRestrictions.Values {
+		key := branchRestrictionKey(branchRestriction)
+		existing[key] = append(existing[key], branchRestriction)
+	}
+
+	for _, restriction := range plan.Restrictions {
+		var newBranchRestriction client.BranchRestriction
+		restriction.mapTo(&newBranchRestriction)
+
+		key := branchRestrictionKey(newBranchRestriction)
+		if len(existing[key]) == 0 {
+			_, err := c.CreateBranchRestriction(repositorySlug, newBranchRestriction)
+			if err != nil {
+				diags.AddError(
+					"Error Applying Bitbucket Branch Restrictions",
+					"Could not create branch restriction, unexpected error: "+err.Error(),
+				)
+				return diags
+			}
+			continue
+		}
+
+		current := existing[key][0]
+		existing[key] = existing[key][1:]
+
+		if sameBranchRestrictionMembers(current, newBranchRestriction) {
+			continue
+		}
+
+		_, err := c.UpdateBranchRestriction(repositorySlug, current.ID, newBranchRestriction)
+		if err != nil {
+			diags.AddError(
+				"Error Applying Bitbucket Branch Restrictions",
+				"Could not update branch restriction, unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	for _, unmanaged := range existing {
+		for _, branchRestriction := range unmanaged {
+			err := c.DeleteBranchRestriction(repositorySlug, branchRestriction.ID)
+			if err != nil {
+				diags.AddError(
+					"Error Applying Bitbucket Branch Restrictions",
+					"Could not delete branch restriction, unexpected error: "+err.Error(),
+				)
+				return diags
+			}
+		}
+	}
+
+	branchRestrictions, err = c.GetBranchRestrictions(repositorySlug)
+	if err != nil {
+		diags.AddError(
+			"Error Applying Bitbucket Branch Restrictions",
+			"Could not read branch restrictions, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	plan.mapFrom(branchRestrictions.Values)
+
+	return diags
+}
+
+func branchRestrictionKey(c client.BranchRestriction) string {
+	value := ""
+	if c.Value != nil {
+		value = fmt.Sprint(*c.Value)
+	}
+
+	return strings.Join([]string{
+		c.Kind,
+		c.BranchMatchKind,
+		types.StringPointerValue(c.BranchType).ValueString(),
+		c.Pattern,
+		value,
+	}, "\x00")
+}
+
+func sameBranchRestrictionMembers(a, b client.BranchRestriction) bool {
+	var aUsers, bUsers []string
+	for _, user := range a.Users {
+		aUsers = append(aUsers, user.Uuid)
+	}
+	for _, user := range b.Users {
+		bUsers = append(bUsers, user.Uuid)
+	}
+
+	var aGroups, bGroups []string
+	for _, group := range a.Groups {
+		aGroups = append(aGroups, group.Slug)
+	}
+	for _, group := range b.Groups {
+		bGroups = append(bGroups, group.Slug)
+	}
+
+	return sameStrings(aUsers, bUsers) && sameStrings(aGroups, bGroups)
+}
+
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a = append([]string{}, a...)
+	b = append([]string{}, b...)
+	sort.Strings(a)
+	sort.Strings(b)
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m *repositoryBranchRestrictionModel) mapTo(c *client.BranchRestriction) {
+	var users []client.User
+	for _, user := range m.Users {
+		users = append(users, client.User{
+			Uuid: user.Uuid.ValueString(),
+		})
+	}
+
+	var groups []client.Group
+	for _, group := range m.Groups {
+		groups = append(groups, client.Group{
+			Slug: group.Slug.ValueString(),
+		})
+	}
+
+	c.Kind = m.Kind.ValueString()
+	c.BranchMatchKind = m.BranchMatchKind.ValueString()
+	c.BranchType = m.BranchType.ValueStringPointer()
+	c.Pattern = m.Pattern.ValueString()
+	c.Value = m.Value.ValueInt64Pointer()
+	c.Users = users
+	c.Groups = groups
+}
+
+func (m *repositoryBranchRestrictionModel) mapFrom(c *client.BranchRestriction) {
+	m.Kind = types.StringValue(c.Kind)
+	m.BranchMatchKind = types.StringValue(c.BranchMatchKind)
+	m.BranchType = types.StringPointerValue(c.BranchType)
+	m.Pattern = types.StringValue(c.Pattern)
+	m.Value = types.Int64PointerValue(c.Value)
+
+	// Empty lists stay empty rather than null when they were configured that
+	// way, since Bitbucket does not distinguish the two.
+	users := m.Users
+	m.Users = nil
+	if users != nil {
+		m.Users = []userUuidModel{}
+	}
+	for _, user := range c.Users {
+		m.Users = append(m.Users, userUuidModel{
+			Uuid: types.StringValue(user.Uuid),
+		})
+	}
+
+	groups := m.Groups
+	m.Groups = nil
+	if groups != nil {
+		m.Groups = []groupModel{}
+	}
+	for _, group := range c.Groups {
+		m.Groups = append(m.Groups, groupModel{
+			Slug: types.StringValue(group.Slug),
+		})
+	}
+}
+
+// mapFrom pairs every restriction returned by Bitbucket with the prior
+// restriction of the same key, so that its users and groups keep their shape.
+func (m *repositoryBranchRestrictionsResourceModel) mapFrom(c []client.BranchRestriction) {
+	prior := map[string][]repositoryBranchRestrictionModel{}
+	for _, restriction := range m.Restrictions {
+		var branchRestriction client.BranchRestriction
+		restriction.mapTo(&branchRestriction)
+
+		key := branchRestrictionKey(branchRestriction)
+		prior[key] = append(prior[key], restriction)
+	}
+
+	m.Restrictions = []repositoryBranchRestrictionModel{}
+	for _, branchRestriction := range c {
+		var restriction repositoryBranchRestrictionModel
+
+		key := branchRestrictionKey(branchRestriction)
+		if len(prior[key]) > 0 {
+			restriction = prior[key][0]
+			prior[key] = prior[key][1:]
+		}
+
+		restriction.mapFrom(&branchRestriction)
+		m.Restrictions = append(m.Restrictions, restriction)
+	}
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/afagund/terraform-provider-bitbucket/client"
@@ -15,12 +16,20 @@ var (
 	_ datasource.DataSourceWithConfigure = &repositoryDataSource{}
 )
 
+type pullRequestSettingsModel struct {
+	MergeStrategies              []types.String `tfsdk:"merge_strategies"`
+	DefaultMergeStrategy         types.String   `tfsdk:"default_merge_strategy"`
+	DeleteSourceBranchAfterMerge types.Bool     `tfsdk:"delete_source_branch_after_merge"`
+}
+
 type repositoryDataSourceModel struct {
-	Slug      types.String  `tfsdk:"slug"`
-	IsPrivate types.Bool    `tfsdk:"is_private"`
-	Scm       types.String  `tfsdk:"scm"`
-	Project   *projectModel `tfsdk:"project"`
-	Website   types.String  `tfsdk:"website"`
+	Workspace           types.String              `tfsdk:"workspace"`
+	Slug                types.String              `tfsdk:"slug"`
+	IsPrivate           types.Bool                `tfsdk:"is_private"`
+	Scm                 types.String              `tfsdk:"scm"`
+	Project             *projectModel             `tfsdk:"project"`
+	Website             types.String              `tfsdk:"website"`
+	PullRequestSettings *pullRequestSettingsModel `tfsdk:"pull_request_settings"`
 }
 
 func NewRepositoryDataSource() datasource.DataSource {
@@ -38,6 +47,9 @@ func (d *repositoryDataSource) Metadata(_ context.Context, req datasource.Metada
 func (d *repositoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+			},
 			"slug": schema.StringAttribute{
 				Required: true,
 			},
@@ -58,6 +70,21 @@ func (d *repositoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 			"website": schema.StringAttribute{
 				Optional: true,
 			},
+			"pull_request_settings": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"merge_strategies": schema.SetAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"default_merge_strategy": schema.StringAttribute{
+						Computed: true,
+					},
+					"delete_source_branch_after_merge": schema.BoolAttribute{
+						Computed: true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -69,7 +96,9 @@ func (d *repositoryDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	repository, err := d.client.GetRepository(state.Slug.ValueString())
+	c := d.client.WithWorkspace(state.Workspace.ValueString())
+
+	repository, err := c.GetRepository(state.Slug.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Bitbucket Repository",
@@ -80,6 +109,29 @@ func (d *repositoryDataSource) Read(ctx context.Context, req datasource.ReadRequ
 
 	state.mapFrom(repository)
 
+	pullRequestSettings, err := c.GetPullRequestSettings(state.Slug.ValueString())
+	if err != nil {
+		var statusErr client.StatusErr
+		if !errors.As(err, &statusErr) || (statusErr.StatusCode != 403 && statusErr.StatusCode != 404) {
+			resp.Diagnostics.AddError(
+				"Unable to Read Bitbucket Pull Request Settings",
+				"Could not read Bitbucket pull request settings for repository "+state.Slug.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if pullRequestSettings != nil {
+		state.PullRequestSettings = &pullRequestSettingsModel{
+			MergeStrategies:              []types.String{},
+			DefaultMergeStrategy:         types.StringValue(pullRequestSettings.DefaultMergeStrategy),
+			DeleteSourceBranchAfterMerge: types.BoolValue(pullRequestSettings.DeleteSourceBranchAfterMerge),
+		}
+		for _, mergeStrategy := range pullRequestSettings.MergeStrategies {
+			state.PullRequestSettings.MergeStrategies = append(state.PullRequestSettings.MergeStrategies, types.StringValue(mergeStrategy))
+		}
+	}
+
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -92,17 +144,17 @@ func (d *repositoryDataSource) Configure(_ context.Context, req datasource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*bitbucketProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = data.client
 }
 
 func (m *repositoryDataSourceModel) mapFrom(c *client.Repository) {
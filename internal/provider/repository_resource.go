@@ -16,6 +16,7 @@ var (
 	_ resource.Resource                = &repositoryResource{}
 	_ resource.ResourceWithConfigure   = &repositoryResource{}
 	_ resource.ResourceWithImportState = &repositoryResource{}
+	_ resource.ResourceWithModifyPlan  = &repositoryResource{}
 )
 
 type projectModel struct {
@@ -23,6 +24,7 @@ type projectModel struct {
 }
 
 type repositoryResourceModel struct {
+	Workspace types.String  `tfsdk:"workspace"`
 	Slug      types.String  `tfsdk:"slug"`
 	IsPrivate types.Bool    `tfsdk:"is_private"`
 	Scm       types.String  `tfsdk:"scm"`
@@ -45,6 +47,10 @@ func (r *repositoryResource) Metadata(_ context.Context, req resource.MetadataRe
 func (r *repositoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
 			"slug": schema.StringAttribute{
 				Required: true,
 			},
@@ -69,6 +75,10 @@ func (r *repositoryResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+func (r *repositoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
 func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan repositoryResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -77,10 +87,12 @@ func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
 	var newRepository client.Repository
 	plan.mapTo(&newRepository)
 
-	repository, err := r.client.CreateRepository(plan.Slug.ValueString(), newRepository)
+	repository, err := r.client.WithWorkspace(plan.Workspace.ValueString()).CreateRepository(plan.Slug.ValueString(), newRepository)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating repository",
@@ -106,7 +118,9 @@ func (r *repositoryResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	repository, err := r.client.GetRepository(state.Slug.ValueString())
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	repository, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetRepository(state.Slug.ValueString())
 	if err != nil {
 		var statusErr client.StatusErr
 		if errors.As(err, &statusErr) {
@@ -143,7 +157,7 @@ func (r *repositoryResource) Update(ctx context.Context, req resource.UpdateRequ
 	var newRepository client.Repository
 	plan.mapTo(&newRepository)
 
-	repository, err := r.client.UpdateRepository(plan.Slug.ValueString(), newRepository)
+	repository, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdateRepository(plan.Slug.ValueString(), newRepository)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Bitbucket Repository",
@@ -169,7 +183,7 @@ func (r *repositoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	err := r.client.DeleteRepository(state.Slug.ValueString())
+	err := r.client.WithWorkspace(state.Workspace.ValueString()).DeleteRepository(state.Slug.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Bitbucket Repository",
@@ -184,22 +198,28 @@ func (r *repositoryResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*bitbucketProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
 }
 
 func (r *repositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("slug"), req, resp)
+	workspace, slug := splitImportWorkspace(req.ID)
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), slug)...)
 }
 
 func (m *repositoryResourceModel) mapTo(c *client.Repository) {
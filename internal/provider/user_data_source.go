@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                   = &userDataSource{}
+	_ datasource.DataSourceWithConfigure      = &userDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &userDataSource{}
+)
+
+type userDataSourceModel struct {
+	Workspace   types.String `tfsdk:"workspace"`
+	Uuid        types.String `tfsdk:"uuid"`
+	AccountID   types.String `tfsdk:"account_id"`
+	Nickname    types.String `tfsdk:"nickname"`
+	Email       types.String `tfsdk:"email"`
+	DisplayName types.String `tfsdk:"display_name"`
+}
+
+func NewUserDataSource() datasource.DataSource {
+	return &userDataSource{}
+}
+
+type userDataSource struct {
+	client *client.Client
+}
+
+func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+			},
+			"uuid": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"account_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"nickname": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"email": schema.StringAttribute{
+				Optional: true,
+			},
+			"display_name": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *userDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config userDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set := 0
+	for _, identifier := range []types.String{config.Uuid, config.AccountID, config.Nickname, config.Email} {
+		if !identifier.IsNull() {
+			set++
+		}
+	}
+
+	if set != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid User Lookup",
+			"Exactly one of uuid, account_id, nickname or email must be set.",
+		)
+	}
+}
+
+func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state userDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user *client.User
+	var err error
+
+	switch {
+	case isKnown(state.Uuid):
+		user, err = d.client.GetUser(state.Uuid.ValueString())
+	case isKnown(state.AccountID):
+		user, err = d.client.GetUser(state.AccountID.ValueString())
+	default:
+		user, err = resolveUser(d.client.WithWorkspace(state.Workspace.ValueString()), userModel{
+			Nickname: state.Nickname,
+			Email:    state.Email,
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Bitbucket User",
+			"Could not read Bitbucket user: "+err.Error(),
+		)
+		return
+	}
+
+	state.mapFrom(user)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *userDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+}
+
+func (m *userDataSourceModel) mapFrom(c *client.User) {
+	m.Uuid = stringValueOrNull(c.Uuid)
+	m.AccountID = stringValueOrNull(c.AccountID)
+	m.Nickname = stringValueOrNull(c.Nickname)
+	m.DisplayName = stringValueOrNull(c.DisplayName)
+}
+
+func resolveUser(c *client.Client, m userModel) (*client.User, error) {
+	switch {
+	case isKnown(m.AccountID):
+		workspaceMembership, err := c.GetWorkspaceMember(m.AccountID.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		return &workspaceMembership.User, nil
+	case isKnown(m.Email):
+		workspaceMemberships, err := c.GetWorkspaceMembersByEmail(m.Email.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		if len(workspaceMemberships.Values) != 1 {
+			return nil, fmt.Errorf("expected one workspace member with email %q, found %d", m.Email.ValueString(), len(workspaceMemberships.Values))
+		}
+
+		return &workspaceMemberships.Values[0].User, nil
+	case isKnown(m.Nickname):
+		workspaceMemberships, err := c.GetWorkspaceMembers()
+		if err != nil {
+			return nil, err
+		}
+
+		var users []client.User
+		for _, workspaceMembership := range workspaceMemberships.Values {
+			if workspaceMembership.User.Nickname == m.Nickname.ValueString() {
+				users = append(users, workspaceMembership.User)
+			}
+		}
+
+		if len(users) != 1 {
+			return nil, fmt.Errorf("expected one workspace member with nickname %q, found %d", m.Nickname.ValueString(), len(users))
+		}
+
+		return &users[0], nil
+	}
+
+	return nil, fmt.Errorf("one of account_id, nickname or email must be set")
+}
+
+func isKnown(v types.String) bool {
+	return !v.IsNull() && !v.IsUnknown()
+}
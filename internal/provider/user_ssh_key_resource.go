@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &userSshKeyResource{}
+	_ resource.ResourceWithConfigure   = &userSshKeyResource{}
+	_ resource.ResourceWithImportState = &userSshKeyResource{}
+)
+
+type userSshKeyResourceModel struct {
+	Uuid    types.String `tfsdk:"uuid"`
+	User    types.String `tfsdk:"user"`
+	Key     types.String `tfsdk:"key"`
+	Label   types.String `tfsdk:"label"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+func NewUserSshKeyResource() resource.Resource {
+	return &userSshKeyResource{}
+}
+
+type userSshKeyResource struct {
+	client *client.Client
+}
+
+func (r *userSshKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_ssh_key"
+}
+
+func (r *userSshKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					sshKeyRequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+			},
+			"comment": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					commentUseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *userSshKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userSshKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var newSshKey client.SshKey
+	plan.mapTo(&newSshKey)
+
+	sshKey, err := r.client.CreateUserSshKey(plan.User.ValueString(), newSshKey)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating user SSH key",
+			"Could not create user SSH key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(sshKey)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *userSshKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userSshKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sshKey, err := r.client.GetUserSshKey(state.User.ValueString(), state.Uuid.ValueString())
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) {
+			if statusErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Bitbucket User SSH Key",
+			"Could not read Bitbucket user SSH key "+state.Uuid.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.mapFrom(sshKey)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *userSshKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan userSshKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var newSshKey client.SshKey
+	plan.mapTo(&newSshKey)
+
+	sshKey, err := r.client.UpdateUserSshKey(plan.User.ValueString(), plan.Uuid.ValueString(), newSshKey)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Bitbucket User SSH Key",
+			"Could not update user SSH key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(sshKey)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *userSshKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userSshKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteUserSshKey(state.User.ValueString(), state.Uuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Bitbucket User SSH Key",
+			"Could not delete user SSH key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *userSshKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *userSshKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: user,uuid. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), idParts[1])...)
+}
+
+func (m *userSshKeyResourceModel) mapTo(c *client.SshKey) {
+	c.Key = m.Key.ValueString()
+	c.Label = m.Label.ValueString()
+}
+
+func (m *userSshKeyResourceModel) mapFrom(c *client.SshKey) {
+	m.Uuid = types.StringValue(c.Uuid)
+	m.Label = types.StringValue(c.Label)
+	m.Comment = types.StringValue(c.Comment)
+
+	if normalizeSSHKey(m.Key.ValueString()) != normalizeSSHKey(c.Key) {
+		m.Key = types.StringValue(c.Key)
+	}
+}
+
+var _ planmodifier.String = commentPlanModifier{}
+
+type commentPlanModifier struct{}
+
+// commentUseStateForUnknown copies the comment from the prior state as long
+// as the key it was read from is unchanged.
+func commentUseStateForUnknown() planmodifier.String {
+	return commentPlanModifier{}
+}
+
+func (m commentPlanModifier) Description(_ context.Context) string {
+	return "Copies the comment in the prior state to the plan unless the key changes."
+}
+
+func (m commentPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m commentPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planKey, stateKey types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("key"), &planKey)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("key"), &stateKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if planKey.Equal(stateKey) {
+		resp.PlanValue = req.StateValue
+	}
+}
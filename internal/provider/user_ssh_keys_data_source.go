@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &userSshKeysDataSource{}
+	_ datasource.DataSourceWithConfigure = &userSshKeysDataSource{}
+)
+
+type sshKeyModel struct {
+	Uuid    types.String `tfsdk:"uuid"`
+	Key     types.String `tfsdk:"key"`
+	Label   types.String `tfsdk:"label"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+type userSshKeysDataSourceModel struct {
+	User types.String  `tfsdk:"user"`
+	Keys []sshKeyModel `tfsdk:"keys"`
+}
+
+func NewUserSshKeysDataSource() datasource.DataSource {
+	return &userSshKeysDataSource{}
+}
+
+type userSshKeysDataSource struct {
+	client *client.Client
+}
+
+func (d *userSshKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_ssh_keys"
+}
+
+func (d *userSshKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"user": schema.StringAttribute{
+				Required: true,
+			},
+			"keys": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Computed: true,
+						},
+						"key": schema.StringAttribute{
+							Computed: true,
+						},
+						"label": schema.StringAttribute{
+							Computed: true,
+						},
+						"comment": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *userSshKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state userSshKeysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sshKeys, err := d.client.GetUserSshKeys(state.User.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Bitbucket User SSH Keys",
+			"Could not read Bitbucket SSH keys for user "+state.User.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.mapFrom(sshKeys.Values)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *userSshKeysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+}
+
+func (m *userSshKeysDataSourceModel) mapFrom(c []client.SshKey) {
+	m.Keys = []sshKeyModel{}
+	for _, sshKey := range c {
+		m.Keys = append(m.Keys, sshKeyModel{
+			Uuid:    types.StringValue(sshKey.Uuid),
+			Key:     types.StringValue(sshKey.Key),
+			Label:   types.StringValue(sshKey.Label),
+			Comment: types.StringValue(sshKey.Comment),
+		})
+	}
+}
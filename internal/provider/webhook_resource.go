@@ -0,0 +1,373 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &webhookResource{}
+	_ resource.ResourceWithConfigure   = &webhookResource{}
+	_ resource.ResourceWithImportState = &webhookResource{}
+	_ resource.ResourceWithModifyPlan  = &webhookResource{}
+)
+
+var webhookEvents = []string{
+	"issue:comment_created",
+	"issue:created",
+	"issue:updated",
+	"project:updated",
+	"pullrequest:approved",
+	"pullrequest:changes_request_created",
+	"pullrequest:changes_request_removed",
+	"pullrequest:comment_created",
+	"pullrequest:comment_deleted",
+	"pullrequest:comment_reopened",
+	"pullrequest:comment_resolved",
+	"pullrequest:comment_updated",
+	"pullrequest:created",
+	"pullrequest:fulfilled",
+	"pullrequest:rejected",
+	"pullrequest:unapproved",
+	"pullrequest:updated",
+	"repo:commit_comment_created",
+	"repo:commit_status_created",
+	"repo:commit_status_updated",
+	"repo:created",
+	"repo:deleted",
+	"repo:fork",
+	"repo:imported",
+	"repo:push",
+	"repo:transfer",
+	"repo:updated",
+}
+
+type webhookResourceModel struct {
+	Workspace      types.String   `tfsdk:"workspace"`
+	Uuid           types.String   `tfsdk:"uuid"`
+	RepositorySlug types.String   `tfsdk:"repository_slug"`
+	Url            types.String   `tfsdk:"url"`
+	Description    types.String   `tfsdk:"description"`
+	Active         types.Bool     `tfsdk:"active"`
+	Events         []types.String `tfsdk:"events"`
+	Secret         types.String   `tfsdk:"secret"`
+}
+
+func NewWebhookResource() resource.Resource {
+	return &webhookResource{}
+}
+
+type webhookResource struct {
+	client *client.Client
+}
+
+func (r *webhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (r *webhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository_slug": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+			},
+			"active": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"events": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					webhookEventsValidator{},
+				},
+			},
+			"secret": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (r *webhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
+func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan webhookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	var newWebhook client.Webhook
+	plan.mapTo(&newWebhook)
+
+	webhook, err := r.client.WithWorkspace(plan.Workspace.ValueString()).CreateWebhook(plan.RepositorySlug.ValueString(), newWebhook)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating webhook",
+			"Could not create webhook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(webhook)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state webhookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	webhook, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetWebhook(state.RepositorySlug.ValueString(), state.Uuid.ValueString())
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) {
+			if statusErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Bitbucket Webhook",
+			"Could not read Bitbucket webhook "+state.Uuid.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.mapFrom(webhook)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *webhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan webhookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state webhookResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var newWebhook client.Webhook
+	plan.mapTo(&newWebhook)
+
+	if plan.Secret.IsNull() && !state.Secret.IsNull() {
+		emptySecret := ""
+		newWebhook.Secret = &emptySecret
+	}
+
+	webhook, err := r.client.WithWorkspace(plan.Workspace.ValueString()).UpdateWebhook(plan.RepositorySlug.ValueString(), plan.Uuid.ValueString(), newWebhook)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Bitbucket Webhook",
+			"Could not update webhook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.mapFrom(webhook)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *webhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state webhookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.WithWorkspace(state.Workspace.ValueString()).DeleteWebhook(state.RepositorySlug.ValueString(), state.Uuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Bitbucket Webhook",
+			"Could not delete webhook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *webhookResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *webhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, importID := splitImportWorkspace(req.ID)
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+
+	if !strings.Contains(importID, ",") {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), importID)...)
+		return
+	}
+
+	idParts := strings.Split(importID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: [workspace/]repository_slug,uuid or [workspace/]uuid. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_slug"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), idParts[1])...)
+}
+
+func (m *webhookResourceModel) mapTo(c *client.Webhook) {
+	var events []string
+	for _, event := range m.Events {
+		events = append(events, event.ValueString())
+	}
+
+	c.Url = m.Url.ValueString()
+	c.Description = m.Description.ValueString()
+	c.Active = m.Active.ValueBool()
+	c.Events = events
+	c.Secret = m.Secret.ValueStringPointer()
+}
+
+func (m *webhookResourceModel) mapFrom(c *client.Webhook) {
+	m.Uuid = types.StringValue(c.Uuid)
+	m.Url = types.StringValue(c.Url)
+	m.Description = types.StringValue(c.Description)
+	m.Active = types.BoolValue(c.Active)
+
+	m.Events = []types.String{}
+	for _, event := range c.Events {
+		m.Events = append(m.Events, types.StringValue(event))
+	}
+}
+
+var _ validator.Set = webhookEventsValidator{}
+
+type webhookEventsValidator struct{}
+
+func (v webhookEventsValidator) Description(_ context.Context) string {
+	return "events must be valid Bitbucket webhook event names"
+}
+
+func (v webhookEventsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v webhookEventsValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var events []types.String
+	resp.Diagnostics.Append(req.ConfigValue.ElementsAs(ctx, &events, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, event := range events {
+		if event.IsNull() || event.IsUnknown() {
+			continue
+		}
+
+		valid := false
+		for _, webhookEvent := range webhookEvents {
+			if event.ValueString() == webhookEvent {
+				valid = true
+				break
+			}
+		}
+
+		if !valid {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Webhook Event",
+				fmt.Sprintf("%q is not a Bitbucket webhook event. Expected one of: %s.", event.ValueString(), strings.Join(webhookEvents, ", ")),
+			)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &workspaceAccessTokenResource{}
+	_ resource.ResourceWithConfigure   = &workspaceAccessTokenResource{}
+	_ resource.ResourceWithImportState = &workspaceAccessTokenResource{}
+	_ resource.ResourceWithModifyPlan  = &workspaceAccessTokenResource{}
+)
+
+type workspaceAccessTokenResourceModel struct {
+	Workspace types.String   `tfsdk:"workspace"`
+	ID        types.String   `tfsdk:"id"`
+	Name      types.String   `tfsdk:"name"`
+	Scopes    []types.String `tfsdk:"scopes"`
+	ExpiresAt types.String   `tfsdk:"expires_at"`
+	Token     types.String   `tfsdk:"token"`
+}
+
+func NewWorkspaceAccessTokenResource() resource.Resource {
+	return &workspaceAccessTokenResource{}
+}
+
+type workspaceAccessTokenResource struct {
+	client *client.Client
+}
+
+func (r *workspaceAccessTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_access_token"
+}
+
+func (r *workspaceAccessTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: accessTokenSchemaAttributes(),
+	}
+}
+
+func (r *workspaceAccessTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspacePlan(ctx, r.client, req, resp)
+}
+
+func (r *workspaceAccessTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan workspaceAccessTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspace = workspaceValue(r.client, plan.Workspace)
+
+	newAccessToken := accessTokenFrom(plan.Name, plan.Scopes, plan.ExpiresAt)
+
+	accessToken, err := r.client.WithWorkspace(plan.Workspace.ValueString()).CreateWorkspaceAccessToken(newAccessToken)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating workspace access token",
+			"Could not create workspace access token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Token = types.StringValue(accessToken.Token)
+	plan.ID, plan.Name, plan.Scopes, plan.ExpiresAt = accessTokenAttributesFrom(accessToken, plan.ExpiresAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *workspaceAccessTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state workspaceAccessTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Workspace = workspaceValue(r.client, state.Workspace)
+
+	accessToken, err := r.client.WithWorkspace(state.Workspace.ValueString()).GetWorkspaceAccessToken(state.ID.ValueString())
+	if err != nil {
+		var statusErr client.StatusErr
+		if errors.As(err, &statusErr) {
+			if statusErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Bitbucket Workspace Access Token",
+			"Could not read Bitbucket workspace access token "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID, state.Name, state.Scopes, state.ExpiresAt = accessTokenAttributesFrom(accessToken, state.ExpiresAt)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *workspaceAccessTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan workspaceAccessTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *workspaceAccessTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state workspaceAccessTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.WithWorkspace(state.Workspace.ValueString()).DeleteWorkspaceAccessToken(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Bitbucket Workspace Access Token",
+			"Could not delete workspace access token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *workspaceAccessTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *workspaceAccessTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, id := splitImportWorkspace(req.ID)
+	if workspace == "" {
+		workspace = r.client.Workspace
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &workspaceDataSource{}
+	_ datasource.DataSourceWithConfigure = &workspaceDataSource{}
+)
+
+type workspaceLinksModel struct {
+	Self   types.String `tfsdk:"self"`
+	Html   types.String `tfsdk:"html"`
+	Avatar types.String `tfsdk:"avatar"`
+}
+
+type workspaceDataSourceModel struct {
+	Slug      types.String         `tfsdk:"slug"`
+	Uuid      types.String         `tfsdk:"uuid"`
+	Name      types.String         `tfsdk:"name"`
+	IsPrivate types.Bool           `tfsdk:"is_private"`
+	Links     *workspaceLinksModel `tfsdk:"links"`
+}
+
+func NewWorkspaceDataSource() datasource.DataSource {
+	return &workspaceDataSource{}
+}
+
+type workspaceDataSource struct {
+	client *client.Client
+}
+
+func (d *workspaceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace"
+}
+
+func (d *workspaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"slug": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"uuid": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+			},
+			"is_private": schema.BoolAttribute{
+				Computed: true,
+			},
+			"links": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"self": schema.StringAttribute{
+						Computed: true,
+					},
+					"html": schema.StringAttribute{
+						Computed: true,
+					},
+					"avatar": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *workspaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state workspaceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slug := d.client.Workspace
+	if !state.Slug.IsNull() {
+		slug = state.Slug.ValueString()
+	}
+
+	workspace, err := d.client.GetWorkspace(slug)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Bitbucket Workspace",
+			"Could not read Bitbucket workspace "+slug+": "+err.Error(),
+		)
+		return
+	}
+
+	state.mapFrom(workspace)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *workspaceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+}
+
+func (m *workspaceDataSourceModel) mapFrom(c *client.Workspace) {
+	m.Slug = types.StringValue(c.Slug)
+	m.Uuid = types.StringValue(c.Uuid)
+	m.Name = types.StringValue(c.Name)
+	m.IsPrivate = types.BoolValue(c.IsPrivate)
+	m.Links = &workspaceLinksModel{
+		Self:   types.StringValue(c.Links.Self.Href),
+		Html:   types.StringValue(c.Links.Html.Href),
+		Avatar: types.StringValue(c.Links.Avatar.Href),
+	}
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/afagund/terraform-provider-bitbucket/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &workspaceMembersDataSource{}
+	_ datasource.DataSourceWithConfigure = &workspaceMembersDataSource{}
+)
+
+type workspaceMemberModel struct {
+	Uuid        types.String `tfsdk:"uuid"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Nickname    types.String `tfsdk:"nickname"`
+	AccountID   types.String `tfsdk:"account_id"`
+}
+
+type workspaceMembersDataSourceModel struct {
+	Workspace  types.String           `tfsdk:"workspace"`
+	Permission types.String           `tfsdk:"permission"`
+	Members    []workspaceMemberModel `tfsdk:"members"`
+}
+
+func NewWorkspaceMembersDataSource() datasource.DataSource {
+	return &workspaceMembersDataSource{}
+}
+
+type workspaceMembersDataSource struct {
+	client *client.Client
+}
+
+func (d *workspaceMembersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_members"
+}
+
+func (d *workspaceMembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				Optional: true,
+			},
+			"permission": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringOneOf("owner", "collaborator", "member"),
+				},
+			},
+			"members": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Computed: true,
+						},
+						"display_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"nickname": schema.StringAttribute{
+							Computed: true,
+						},
+						"account_id": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *workspaceMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state workspaceMembersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var users []client.User
+
+	if state.Permission.IsNull() {
+		workspaceMemberships, err := d.client.WithWorkspace(state.Workspace.ValueString()).GetWorkspaceMembers()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Bitbucket Workspace Members",
+				"Could not read Bitbucket workspace members: "+err.Error(),
+			)
+			return
+		}
+
+		for _, workspaceMembership := range workspaceMemberships.Values {
+			users = append(users, workspaceMembership.User)
+		}
+	} else {
+		workspacePermissions, err := d.client.WithWorkspace(state.Workspace.ValueString()).GetWorkspacePermissions()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Bitbucket Workspace Members",
+				"Could not read Bitbucket workspace permissions: "+err.Error(),
+			)
+			return
+		}
+
+		for _, workspacePermission := range workspacePermissions.Values {
+			if workspacePermission.Permission == state.Permission.ValueString() {
+				users = append(users, workspacePermission.User)
+			}
+		}
+	}
+
+	state.mapFrom(users)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *workspaceMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*bitbucketProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitbucketProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+}
+
+func (m *workspaceMembersDataSourceModel) mapFrom(c []client.User) {
+	m.Members = []workspaceMemberModel{}
+	for _, user := range c {
+		m.Members = append(m.Members, workspaceMemberModel{
+			Uuid:        types.StringValue(user.Uuid),
+			DisplayName: types.StringValue(user.DisplayName),
+			Nickname:    types.StringValue(user.Nickname),
+			AccountID:   types.StringValue(user.AccountID),
+		})
+	}
+}